@@ -0,0 +1,128 @@
+package test
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Describe returns a T that prefixes the failure message of every assertion
+// made against it with a description of the current scope, such as the case
+// of a table-driven test.  Scopes can be nested by describing a T that was
+// itself returned by Describe.  Assertions report directly to the T that was
+// described, so failures are attributed to the line of the test that made the
+// assertion.
+func Describe(t T, format string, args ...interface{}) T {
+	return &describedT{t: t, scope: fmt.Sprintf(format, args...)}
+}
+
+// Because returns a new *Assertions about the same subject, x, whose failure
+// messages include the provided reason.
+func (a *Assertions) Because(format string, args ...interface{}) *Assertions {
+	return a.annotated(&annotation{reason: fmt.Sprintf(format, args...)})
+}
+
+// WithContext returns a new *Assertions about the same subject, x, whose
+// failure messages include the provided key and value.  Context is printed in
+// the order it was added.
+func (a *Assertions) WithContext(key string, value interface{}) *Assertions {
+	return a.annotated(&annotation{context: &contextEntry{key: key, value: value}})
+}
+
+func (a *Assertions) annotated(n *annotation) *Assertions {
+	n.previous = a.notes
+
+	return &Assertions{
+		t:       a.t,
+		x:       a.x,
+		negated: a.negated,
+		soft:    a.soft,
+		notes:   n,
+	}
+}
+
+// annotation is a single annotation that formattedFailure adds to failure
+// messages.  Annotations are chained to those added before them, so several
+// can be layered.
+type annotation struct {
+	previous *annotation
+
+	scope   string
+	reason  string
+	context *contextEntry
+}
+
+type contextEntry struct {
+	key   string
+	value interface{}
+}
+
+// describedT marks a T with a scope that is added to the annotations of any
+// assertion made against it.
+type describedT struct {
+	t     T
+	scope string
+}
+
+var _ T = &describedT{}
+
+func (d *describedT) Name() string {
+	return d.t.Name()
+}
+
+func (d *describedT) Helper() {
+	d.t.Helper()
+}
+
+func (d *describedT) Errorf(format string, args ...interface{}) {
+	d.t.Helper()
+	d.t.Errorf(format, args...)
+}
+
+func (d *describedT) Fatalf(format string, args ...interface{}) {
+	d.t.Helper()
+	d.t.Fatalf(format, args...)
+}
+
+// annotate adds the annotation n, and those chained before it, to msg.  Scopes
+// prefix the message outermost first, and the reasons and context follow it
+// in the order they were added.
+func annotate(n *annotation, msg string) string {
+	var scopes, reasons []string
+	var context []*contextEntry
+
+	for ; n != nil; n = n.previous {
+		switch {
+		case n.scope != "":
+			scopes = append([]string{n.scope}, scopes...)
+		case n.reason != "":
+			reasons = append([]string{n.reason}, reasons...)
+		case n.context != nil:
+			context = append([]*contextEntry{n.context}, context...)
+		}
+	}
+
+	sb := &strings.Builder{}
+	for _, scope := range scopes {
+		fmt.Fprintf(sb, "%v: ", scope)
+	}
+
+	sb.WriteString(msg)
+
+	if len(reasons) > 0 || len(context) > 0 {
+		sb.WriteString("\n")
+	}
+
+	for _, reason := range reasons {
+		fmt.Fprintf(sb, "\nbecause: %v", reason)
+	}
+
+	if len(context) > 0 {
+		sb.WriteString("\ncontext:")
+	}
+
+	for _, entry := range context {
+		fmt.Fprintf(sb, "\n  %s: %s", entry.key, formatValue(entry.value))
+	}
+
+	return sb.String()
+}
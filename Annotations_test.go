@@ -0,0 +1,99 @@
+package test
+
+import (
+	"runtime"
+	"testing"
+)
+
+func TestDescribe(t *testing.T) {
+	// Arrange.
+	recorder := NewRecorder()
+
+	// Act.
+	That(Describe(recorder, "case %d", 3), 4).IsEqualTo(5)
+
+	// Assert.
+	assertFailed(t, recorder)
+	assertHelperCount(t, recorder, 3)
+	assertFailureMessage(t, recorder, "case 3: Expected 4 to be equal to 5\nx: int\ny: int")
+}
+
+func TestDescribeNested(t *testing.T) {
+	// Arrange.
+	recorder := NewRecorder()
+	outer := Describe(recorder, "users")
+
+	// Act.
+	That(Describe(outer, "case %d", 1), true).IsFalse()
+
+	// Assert.
+	assertFailed(t, recorder)
+	assertFailureMessage(t, recorder, "users: case 1: Expected <false>, but was <true>")
+}
+
+func TestBecause(t *testing.T) {
+	// Arrange.
+	pass := NewRecorder()
+	fail := NewRecorder()
+
+	// Act.
+	That(pass, true).Because("user %s should be active", "u1").IsTrue()
+	That(fail, false).Because("user %s should be active", "u1").IsTrue()
+
+	// Assert.
+	assertPassed(t, pass)
+	assertHelperCount(t, pass, 2)
+
+	assertFailed(t, fail)
+	assertHelperCount(t, fail, 3)
+	assertFailureMessage(t, fail, "Expected <true>, but was <false>\n\nbecause: user u1 should be active")
+}
+
+func TestWithContext(t *testing.T) {
+	// Arrange.
+	recorder := NewRecorder()
+
+	// Act.
+	That(Describe(recorder, "case 2"), []int{1}).
+		WithContext("region", "eu").
+		Because("the cache was warmed").
+		WithContext("attempt", 2).
+		Not().
+		Contains(1)
+
+	// Assert.
+	assertFailed(t, recorder)
+	assertFailureMessage(t, recorder, "case 2: Expected []int{1} to not contain 1, but it did\n\nbecause: the cache was warmed\ncontext:\n  region: \"eu\"\n  attempt: 2")
+}
+
+func TestAnnotationsWithSoftAssertions(t *testing.T) {
+	// Arrange.
+	recorder := NewRecorder()
+	sut := Soft(Describe(recorder, "case 1"))
+
+	// Act.
+	That(sut, 4).IsEqualTo(5)
+
+	// Assert.
+	assertFailed(t, recorder)
+	assertFailureMessage(t, recorder, "case 1: Expected 4 to be equal to 5")
+}
+
+func TestAnnotationsReportCallerLine(t *testing.T) {
+	// Arrange.
+	recorder := newCallerRecorder()
+	sut := Soft(Describe(recorder, "case 1"))
+
+	// Act.
+	_, _, line, _ := runtime.Caller(0)
+	That(sut, 4).Because("it is four").IsEqualTo(5)
+	That(Describe(sut, "nested"), 4).WithContext("key", "value").IsEqualTo(5)
+
+	// Assert.
+	assertFailed(t, &recorder.Recorder)
+	assertFailureMessage(t, &recorder.Recorder, "case 1: nested: Expected 4 to be equal to 5")
+
+	if len(recorder.lines) != 2 || recorder.lines[0] != line+1 || recorder.lines[1] != line+2 {
+		t.Fatalf("Expected failures to be reported at lines %v and %v but were %v", line+1, line+2, recorder.lines)
+	}
+}
@@ -1,59 +1,87 @@
 package test
 
 import (
-	"fmt"
+	"math"
+	"math/big"
 	"reflect"
+	"strings"
 	"time"
 )
 
 // Assertions defines a number of assertions that can be made about x.
 type Assertions struct {
-	t T
-	x interface{}
+	t       T
+	x       interface{}
+	negated bool
+
+	// soft collects the failures of assertions made with Soft or Check, which
+	// are reported with Errorf rather than Fatalf.
+	soft *SoftT
+
+	// notes are the annotations added to failure messages by Describe,
+	// Because and WithContext.
+	notes *annotation
+}
+
+// Not returns a new *Assertions about the same subject, x, whose assertions
+// are inverted.  For example, That(t, x).Not().IsEqualTo(y) fails the test if
+// y is equal to x.  Assertions that cannot be applied to x at all, such as an
+// ordering assertion on a string, still fail when inverted.
+func (a *Assertions) Not() *Assertions {
+	return &Assertions{
+		t:       a.t,
+		x:       a.x,
+		negated: !a.negated,
+		soft:    a.soft,
+		notes:   a.notes,
+	}
 }
 
 // IsEqualTo fails the test if y is not equal to the subject, x.
 func (a *Assertions) IsEqualTo(y interface{}) {
 	a.t.Helper()
 
-	if baseEqualityTest(a.x, y) {
-		return
+	if m, failed := a.evaluate(equalTo(y)); failed {
+		formattedFailure(a, m.format, m.args...)
 	}
-
-	formattedFailure(a.t, "Expected %v to be equal to %v\nx: %v\ny: %v", a.x, y, typeNameFor(a.x), typeNameFor(y))
 }
 
 // IsNotEqualTo fails the test if y is equal to the subject, x.
 func (a *Assertions) IsNotEqualTo(y interface{}) {
 	a.t.Helper()
 
-	if !baseEqualityTest(a.x, y) {
-		return
+	if m, failed := a.Not().evaluate(equalTo(y)); failed {
+		formattedFailure(a, m.format, m.args...)
 	}
+}
+
+// IsDeeplyEqualTo fails the test if y is not structurally equal to the
+// subject, x.  Structs, maps, slices, arrays, pointers and interfaces are
+// walked recursively, and every path at which x and y differ is reported.
+func (a *Assertions) IsDeeplyEqualTo(y interface{}) {
+	a.t.Helper()
 
-	formattedFailure(a.t, "Expected %v to not be equal to %v\nx: %v\ny: %v", a.x, y, typeNameFor(a.x), typeNameFor(y))
+	if m, failed := a.evaluate(deeplyEqualTo(y)); failed {
+		formattedFailure(a, m.format, m.args...)
+	}
 }
 
 // IsNil fails the test if the subject, x, is not nil.
 func (a *Assertions) IsNil() {
 	a.t.Helper()
 
-	if baseNilTest(a.x) {
-		return
+	if m, failed := a.evaluate(isNil()); failed {
+		formattedFailure(a, m.format, m.args...)
 	}
-
-	formattedFailure(a.t, "Expected %v to be <nil>\nx: %v", a.x, typeNameFor(a.x))
 }
 
 // IsNotNil fails the test if the subject, x, is nil.
 func (a *Assertions) IsNotNil() {
 	a.t.Helper()
 
-	if !baseNilTest(a.x) {
-		return
+	if m, failed := a.Not().evaluate(isNil()); failed {
+		formattedFailure(a, m.format, m.args...)
 	}
-
-	formattedFailure(a.t, "Expected subject to not be <nil>, but was\nx: %v", typeNameFor(a.x))
 }
 
 // HasEquivalentSequenceTo fails the test if the subject, x, does not have the
@@ -61,28 +89,8 @@ func (a *Assertions) IsNotNil() {
 func (a *Assertions) HasEquivalentSequenceTo(y interface{}) {
 	a.t.Helper()
 
-	xt := reflect.TypeOf(a.x)
-	yt := reflect.TypeOf(y)
-	xv := reflect.ValueOf(a.x)
-	yv := reflect.ValueOf(y)
-
-	if xt.Kind() != reflect.Slice || yt.Kind() != reflect.Slice {
-		formattedFailure(a.t, "Expected both subject and comparator to be slices, but subject was a %v and comparator was a %v", xt.Kind(), yt.Kind())
-		return
-	}
-
-	if xt.Elem() != yt.Elem() {
-		formattedFailure(a.t, "Expected subject to have type like %v but was %v", yt, xt)
-		return
-	}
-
-	if xv.Len() != yv.Len() {
-		formattedFailure(a.t, "Expected subject to have length %v but had length %v", yv.Len(), xv.Len())
-		return
-	}
-
-	if !reflect.DeepEqual(a.x, y) {
-		formattedFailure(a.t, "Expected sequence of elements in\n\n%v\n\nto be equal to sequence of elements in\n\n%v", a.x, y)
+	if m, failed := a.evaluate(equivalentSequenceTo(y)); failed {
+		formattedFailure(a, m.format, m.args...)
 	}
 }
 
@@ -90,14 +98,8 @@ func (a *Assertions) HasEquivalentSequenceTo(y interface{}) {
 func (a *Assertions) IsTrue() {
 	a.t.Helper()
 
-	b, ok := baseBooleanTest(a.x)
-	if !ok {
-		formattedFailure(a.t, "Expected <true>, but was not a boolean\nx: %v", typeNameFor(a.x))
-		return
-	}
-
-	if !b {
-		formattedFailure(a.t, "Expected <true>, but was <false>")
+	if m, failed := a.evaluate(isTrue()); failed {
+		formattedFailure(a, m.format, m.args...)
 	}
 }
 
@@ -105,14 +107,8 @@ func (a *Assertions) IsTrue() {
 func (a *Assertions) IsFalse() {
 	a.t.Helper()
 
-	b, ok := baseBooleanTest(a.x)
-	if !ok {
-		formattedFailure(a.t, "Expected <false>, but was not a boolean\nx: %v", typeNameFor(a.x))
-		return
-	}
-
-	if b {
-		formattedFailure(a.t, "Expected <false>, but was <true>")
+	if m, failed := a.Not().evaluate(isTrue()); failed {
+		formattedFailure(a, m.format, m.args...)
 	}
 }
 
@@ -120,14 +116,8 @@ func (a *Assertions) IsFalse() {
 func (a *Assertions) IsGreaterThan(y interface{}) {
 	a.t.Helper()
 
-	b, ok := baseGreaterThanTest(a.x, y)
-	if !ok {
-		formattedFailure(a.t, "Expected two comparable types\nx: %v\ny: %v", typeNameFor(a.x), typeNameFor(y))
-		return
-	}
-
-	if !b {
-		formattedFailure(a.t, "Expected %v to be greater than %v", a.x, y)
+	if m, failed := a.evaluate(greaterThan(y)); failed {
+		formattedFailure(a, m.format, m.args...)
 	}
 }
 
@@ -136,14 +126,8 @@ func (a *Assertions) IsGreaterThan(y interface{}) {
 func (a *Assertions) IsGreaterThanOrEqualTo(y interface{}) {
 	a.t.Helper()
 
-	b, ok := baseGreaterThanOrEqualToTest(a.x, y)
-	if !ok {
-		formattedFailure(a.t, "Expected two comparable types\nx: %v\ny: %v", typeNameFor(a.x), typeNameFor(y))
-		return
-	}
-
-	if !b {
-		formattedFailure(a.t, "Expected %v to be greater than or equal to %v", a.x, y)
+	if m, failed := a.evaluate(greaterThanOrEqualTo(y)); failed {
+		formattedFailure(a, m.format, m.args...)
 	}
 }
 
@@ -151,14 +135,8 @@ func (a *Assertions) IsGreaterThanOrEqualTo(y interface{}) {
 func (a *Assertions) IsLessThan(y interface{}) {
 	a.t.Helper()
 
-	b, ok := baseLessThanTest(a.x, y)
-	if !ok {
-		formattedFailure(a.t, "Expected two comparable types\nx: %v\ny: %v", typeNameFor(a.x), typeNameFor(y))
-		return
-	}
-
-	if !b {
-		formattedFailure(a.t, "Expected %v to be less than %v", a.x, y)
+	if m, failed := a.evaluate(lessThan(y)); failed {
+		formattedFailure(a, m.format, m.args...)
 	}
 }
 
@@ -167,14 +145,134 @@ func (a *Assertions) IsLessThan(y interface{}) {
 func (a *Assertions) IsLessThanOrEqualTo(y interface{}) {
 	a.t.Helper()
 
-	b, ok := baseLessThanOrEqualToTest(a.x, y)
-	if !ok {
-		formattedFailure(a.t, "Expected two comparable types\nx: %v\ny: %v", typeNameFor(a.x), typeNameFor(y))
-		return
+	if m, failed := a.evaluate(lessThanOrEqualTo(y)); failed {
+		formattedFailure(a, m.format, m.args...)
+	}
+}
+
+func equalTo(y interface{}) predicate {
+	return func(x interface{}) outcome {
+		if baseEqualityTest(x, y) {
+			return passed("Expected %v to not be equal to %v\nx: %s\ny: %s", x, y, typeNameFor(x), typeNameFor(y))
+		}
+
+		if diff := stringDiffFor(x, y); diff != "" {
+			return failed("Expected strings to be equal, but they differ\n\n%s", diff)
+		}
+
+		return failed("Expected %v to be equal to %v\nx: %s\ny: %s", x, y, typeNameFor(x), typeNameFor(y))
+	}
+}
+
+func deeplyEqualTo(y interface{}) predicate {
+	return func(x interface{}) outcome {
+		diffs := deepDiff(x, y)
+		if len(diffs) == 0 {
+			return passed("Expected %v to not be deeply equal to %v", x, y)
+		}
+
+		if diff := stringDiffFor(x, y); diff != "" {
+			return failed("Expected strings to be deeply equal, but they differ\n\n%s", diff)
+		}
+
+		return failed("Expected %v to be deeply equal to %v\n\n%s", x, y, strings.Join(diffs, "\n"))
+	}
+}
+
+func isNil() predicate {
+	return func(x interface{}) outcome {
+		if baseNilTest(x) {
+			return passed("Expected subject to not be <nil>, but was\nx: %s", typeNameFor(x))
+		}
+
+		return failed("Expected %v to be <nil>\nx: %s", x, typeNameFor(x))
+	}
+}
+
+func equivalentSequenceTo(y interface{}) predicate {
+	return func(x interface{}) outcome {
+		xt := reflect.TypeOf(x)
+		yt := reflect.TypeOf(y)
+		xv := reflect.ValueOf(x)
+		yv := reflect.ValueOf(y)
+
+		if xv.Kind() != reflect.Slice || yv.Kind() != reflect.Slice {
+			return invalid("Expected both subject and comparator to be slices, but subject was a %s and comparator was a %s", xv.Kind(), yv.Kind())
+		}
+
+		if xt.Elem() != yt.Elem() {
+			return invalid("Expected subject to have type like %s but was %s", yt, xt)
+		}
+
+		if xv.Len() != yv.Len() {
+			return failed("Expected subject to have length %v but had length %v", yv.Len(), xv.Len())
+		}
+
+		if !reflect.DeepEqual(x, y) {
+			return failed("Expected sequence of elements in\n\n%v\n\nto be equal to sequence of elements in\n\n%v", x, y)
+		}
+
+		return passed("Expected sequence of elements in\n\n%v\n\nto not be equal to sequence of elements in\n\n%v", x, y)
+	}
+}
+
+func isTrue() predicate {
+	return func(x interface{}) outcome {
+		b, ok := baseBooleanTest(x)
+		if !ok {
+			return outcome{
+				invalid:  true,
+				failure:  messagef("Expected <true>, but was not a boolean\nx: %s", typeNameFor(x)),
+				negation: messagef("Expected <false>, but was not a boolean\nx: %s", typeNameFor(x)),
+			}
+		}
+
+		if !b {
+			return failed("Expected <true>, but was <false>")
+		}
+
+		return passed("Expected <false>, but was <true>")
 	}
+}
+
+func greaterThan(y interface{}) predicate {
+	return ordering(y, "greater than", func(c int) bool {
+		return c == comparisonGreater
+	})
+}
+
+func greaterThanOrEqualTo(y interface{}) predicate {
+	return ordering(y, "greater than or equal to", func(c int) bool {
+		return c == comparisonGreater || c == comparisonEqual
+	})
+}
+
+func lessThan(y interface{}) predicate {
+	return ordering(y, "less than", func(c int) bool {
+		return c == comparisonLess
+	})
+}
 
-	if !b {
-		formattedFailure(a.t, "Expected %v to be less than or equal to %v", a.x, y)
+func lessThanOrEqualTo(y interface{}) predicate {
+	return ordering(y, "less than or equal to", func(c int) bool {
+		return c == comparisonLess || c == comparisonEqual
+	})
+}
+
+// ordering returns a predicate that compares the subject to y using
+// baseComparison, passing if accept returns true for the result.
+func ordering(y interface{}, relation string, accept func(c int) bool) predicate {
+	return func(x interface{}) outcome {
+		c, ok := baseComparison(x, y)
+		if !ok {
+			return invalid("Expected two comparable types\nx: %s\ny: %s", typeNameFor(x), typeNameFor(y))
+		}
+
+		if !accept(c) {
+			return failed("Expected %v to be %s %v", x, relation, y)
+		}
+
+		return passed("Expected %v to not be %s %v", x, relation, y)
 	}
 }
 
@@ -204,132 +302,144 @@ func baseBooleanTest(x interface{}) (bool, bool) {
 	return b, true
 }
 
-func baseGreaterThanTest(x interface{}, y interface{}) (bool, bool) {
-	xi, ok1 := baseIntegerValue(x)
-	yi, ok2 := baseIntegerValue(y)
-	if ok1 && ok2 {
-		return xi > yi, true
-	}
+// The possible results of baseComparison.  comparisonUnordered is produced
+// when either side is NaN, and fails every ordered comparison.
+const (
+	comparisonLess      = -1
+	comparisonEqual     = 0
+	comparisonGreater   = 1
+	comparisonUnordered = 2
+)
 
-	xf, ok1 := baseFloatingValue(x)
-	yf, ok2 := baseFloatingValue(y)
+// baseComparison orders x relative to y.  Any two numbers can be compared
+// exactly, regardless of kind or signedness, as can two time.Time values.  The
+// second result is false if x and y are not comparable.
+func baseComparison(x interface{}, y interface{}) (int, bool) {
+	xn, ok1 := baseNumberValue(x)
+	yn, ok2 := baseNumberValue(y)
 	if ok1 && ok2 {
-		return xf > yf, true
+		return xn.compare(yn), true
 	}
 
 	xt, ok1 := baseTimeValue(x)
 	yt, ok2 := baseTimeValue(y)
 	if ok1 && ok2 {
-		return xt.Sub(yt) > time.Nanosecond*0, true
+		switch {
+		case xt.Before(yt):
+			return comparisonLess, true
+		case xt.After(yt):
+			return comparisonGreater, true
+		}
+
+		return comparisonEqual, true
 	}
 
-	return false, false
+	return comparisonUnordered, false
 }
 
-func baseGreaterThanOrEqualToTest(x interface{}, y interface{}) (bool, bool) {
-	xi, ok1 := baseIntegerValue(x)
-	yi, ok2 := baseIntegerValue(y)
-	if ok1 && ok2 {
-		return xi >= yi, true
-	}
+// number is an exact representation of any numeric value.  Finite values are
+// held as a rational, while infinities and NaN are flagged separately.
+type number struct {
+	rat *big.Rat
+	inf int
+	nan bool
+}
 
-	xf, ok1 := baseFloatingValue(x)
-	yf, ok2 := baseFloatingValue(y)
-	if ok1 && ok2 {
-		return xf >= yf, true
+// baseNumberValue converts x to a number.  Any value whose kind is an integer
+// or floating point kind is accepted, including named types, as are big.Int,
+// big.Float and big.Rat and pointers to them.
+func baseNumberValue(x interface{}) (number, bool) {
+	switch n := x.(type) {
+	case *big.Int:
+		if n == nil {
+			return number{}, false
+		}
+		return number{rat: new(big.Rat).SetInt(n)}, true
+	case big.Int:
+		return number{rat: new(big.Rat).SetInt(&n)}, true
+	case *big.Rat:
+		if n == nil {
+			return number{}, false
+		}
+		return number{rat: new(big.Rat).Set(n)}, true
+	case big.Rat:
+		return number{rat: new(big.Rat).Set(&n)}, true
+	case *big.Float:
+		if n == nil {
+			return number{}, false
+		}
+		return bigFloatNumber(n), true
+	case big.Float:
+		return bigFloatNumber(&n), true
 	}
 
-	xt, ok1 := baseTimeValue(x)
-	yt, ok2 := baseTimeValue(y)
-	if ok1 && ok2 {
-		return xt.Sub(yt) >= time.Nanosecond*0, true
+	v := reflect.ValueOf(x)
+
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return number{rat: new(big.Rat).SetInt64(v.Int())}, true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return number{rat: new(big.Rat).SetInt(new(big.Int).SetUint64(v.Uint()))}, true
+	case reflect.Float32, reflect.Float64:
+		return floatNumber(v.Float()), true
 	}
 
-	return false, false
+	return number{}, false
 }
 
-func baseLessThanTest(x interface{}, y interface{}) (bool, bool) {
-	xi, ok1 := baseIntegerValue(x)
-	yi, ok2 := baseIntegerValue(y)
-	if ok1 && ok2 {
-		return xi < yi, true
+func floatNumber(f float64) number {
+	switch {
+	case math.IsNaN(f):
+		return number{nan: true}
+	case math.IsInf(f, 1):
+		return number{inf: 1}
+	case math.IsInf(f, -1):
+		return number{inf: -1}
 	}
 
-	xf, ok1 := baseFloatingValue(x)
-	yf, ok2 := baseFloatingValue(y)
-	if ok1 && ok2 {
-		return xf < yf, true
-	}
+	return number{rat: new(big.Rat).SetFloat64(f)}
+}
 
-	xt, ok1 := baseTimeValue(x)
-	yt, ok2 := baseTimeValue(y)
-	if ok1 && ok2 {
-		return xt.Sub(yt) < time.Nanosecond*0, true
+func bigFloatNumber(f *big.Float) number {
+	if f.IsInf() {
+		return number{inf: f.Sign()}
 	}
 
-	return false, false
+	r, _ := f.Rat(nil)
+	return number{rat: r}
 }
 
-func baseLessThanOrEqualToTest(x interface{}, y interface{}) (bool, bool) {
-	xi, ok1 := baseIntegerValue(x)
-	yi, ok2 := baseIntegerValue(y)
-	if ok1 && ok2 {
-		return xi <= yi, true
+// compare returns one of the comparison constants ordering n relative to m.
+func (n number) compare(m number) int {
+	if n.nan || m.nan {
+		return comparisonUnordered
 	}
 
-	xf, ok1 := baseFloatingValue(x)
-	yf, ok2 := baseFloatingValue(y)
-	if ok1 && ok2 {
-		return xf <= yf, true
-	}
+	if n.inf != 0 || m.inf != 0 {
+		switch {
+		case n.inf < m.inf:
+			return comparisonLess
+		case n.inf > m.inf:
+			return comparisonGreater
+		}
 
-	xt, ok1 := baseTimeValue(x)
-	yt, ok2 := baseTimeValue(y)
-	if ok1 && ok2 {
-		return xt.Sub(yt) <= time.Nanosecond*0, true
+		return comparisonEqual
 	}
 
-	return false, false
+	return n.rat.Cmp(m.rat)
 }
 
-func baseIntegerValue(x interface{}) (int64, bool) {
-	switch n := x.(type) {
-	case byte:
-		return int64(n), true
-	case int8:
-		return int64(n), true
-	case uint16:
-		return int64(n), true
-	case int16:
-		return int64(n), true
-	case uint:
-		return int64(n), true
-	case int:
-		return int64(n), true
-	case uint32:
-		return int64(n), true
-	case int32:
-		return int64(n), true
-	case int64:
-		return int64(n), true
-	case uint64:
-		return int64(n), true
-	case uintptr:
-		return int64(n), true
-	}
-
-	return 0, false
-}
-
-func baseFloatingValue(x interface{}) (float64, bool) {
-	switch n := x.(type) {
-	case float32:
-		return float64(n), true
-	case float64:
-		return float64(n), true
+// float64 returns the nearest float64 to n.
+func (n number) float64() float64 {
+	switch {
+	case n.nan:
+		return math.NaN()
+	case n.inf != 0:
+		return math.Inf(n.inf)
 	}
 
-	return 0, false
+	f, _ := n.rat.Float64()
+	return f
 }
 
 func baseTimeValue(x interface{}) (time.Time, bool) {
@@ -337,13 +447,23 @@ func baseTimeValue(x interface{}) (time.Time, bool) {
 	return v, ok
 }
 
-func formattedFailure(t T, format string, args ...interface{}) {
-	t.Helper()
+// formattedFailure reports a failure of an assertion made with a.  The test is
+// stopped unless the assertion was made with Soft or Check.
+func formattedFailure(a *Assertions, format string, args ...interface{}) {
+	a.t.Helper()
+
+	name := a.t.Name()
+	msg := annotate(a.notes, sprintf(format, args...))
+
+	if a.soft != nil {
+		a.soft.record("\n\n× %v\n%v\n\n", name, msg)
+		a.t.Errorf("\n\n× %v\n%v\n\n", name, msg)
+		return
+	}
 
-	name := t.Name()
-	t.Fatalf("\n\n× %v\n%v\n\n", name, fmt.Sprintf(format, args...))
+	a.t.Fatalf("\n\n× %v\n%v\n\n", name, msg)
 }
 
 func typeNameFor(x interface{}) string {
-	return fmt.Sprintf("%v", reflect.TypeOf(x))
+	return formatType(reflect.TypeOf(x))
 }
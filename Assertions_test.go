@@ -1,8 +1,9 @@
 package test
 
 import (
-	"fmt"
 	"io"
+	"math"
+	"math/big"
 	"strings"
 	"testing"
 	"time"
@@ -40,6 +41,22 @@ func TestIsEqualTo(t *testing.T) {
 	}
 }
 
+func TestIsEqualToDiffsMultilineStrings(t *testing.T) {
+	// Arrange.
+	x := "line 1\nline 2\nline 3"
+	y := "line 1\nline two\nline 3"
+
+	// Act.
+	recorder := NewRecorder()
+
+	That(recorder, x).IsEqualTo(y)
+
+	// Assert.
+	assertFailed(t, recorder)
+	assertHelperCount(t, recorder, 3)
+	assertFailureMessage(t, recorder, "Expected strings to be equal, but they differ\n\n--- x\n+++ y\n@@ -1,3 +1,3 @@\n line 1\n-line [-2-]\n+line {+two+}\n line 3")
+}
+
 func TestIsNotEqualTo(t *testing.T) {
 	testCases := []struct {
 		x    interface{}
@@ -71,6 +88,74 @@ func TestIsNotEqualTo(t *testing.T) {
 	}
 }
 
+func TestIsDeeplyEqualTo(t *testing.T) {
+	type item struct {
+		Name  string
+		Price int
+	}
+
+	type order struct {
+		Items []item
+		Tags  map[string]string
+		Note  *string
+	}
+
+	note1 := "fragile"
+	note2 := "fragile"
+
+	testCases := []struct {
+		x    interface{}
+		y    interface{}
+		pass bool
+	}{
+		{x: 4, y: 4, pass: true},
+		{x: 4, y: int16(4), pass: false},
+		{x: []int{1, 2}, y: []int{1, 2}, pass: true},
+		{x: []int{1, 2}, y: []int{1, 3}, pass: false},
+		{x: map[string]int{"a": 1}, y: map[string]int{"a": 1}, pass: true},
+		{x: order{Note: &note1}, y: order{Note: &note2}, pass: true},
+		{x: order{Items: []item{{Price: 12}}}, y: order{Items: []item{{Price: 13}}}, pass: false},
+		{x: nil, y: nil, pass: true},
+	}
+
+	for _, testCase := range testCases {
+		recorder := NewRecorder()
+		That(recorder, testCase.x).IsDeeplyEqualTo(testCase.y)
+
+		if !testCase.pass {
+			assertFailed(t, recorder)
+			assertFailureMessage(t, recorder, "Expected %v to be deeply equal to %v", testCase.x, testCase.y)
+			assertHelperCount(t, recorder, 3)
+		} else {
+			assertPassed(t, recorder)
+			assertHelperCount(t, recorder, 2)
+		}
+	}
+}
+
+func TestIsDeeplyEqualToReportsPaths(t *testing.T) {
+	// Arrange.
+	type item struct {
+		Price int
+	}
+
+	type order struct {
+		Items []item
+	}
+
+	x := order{Items: []item{{Price: 1}, {Price: 12}}}
+	y := order{Items: []item{{Price: 1}, {Price: 13}}}
+
+	// Act.
+	recorder := NewRecorder()
+
+	That(recorder, x).IsDeeplyEqualTo(y)
+
+	// Assert.
+	assertFailed(t, recorder)
+	assertFailureMessage(t, recorder, ".Items[1].Price: 12 != 13")
+}
+
 func TestIsNil(t *testing.T) {
 	testCases := []struct {
 		x    interface{}
@@ -186,7 +271,7 @@ func TestHasEquivalentSequenceToExpectsSameSequence(t *testing.T) {
 	// Assert.
 	assertFailed(t, recorder)
 	assertHelperCount(t, recorder, 3)
-	assertFailureMessage(t, recorder, "Expected sequence of elements in\n\n[1 2 3]\n\nto be equal to sequence of elements in\n\n[1 2 4]")
+	assertFailureMessage(t, recorder, "Expected sequence of elements in\n\n[]uint8{1, 2, 3}\n\nto be equal to sequence of elements in\n\n[]uint8{1, 2, 4}")
 }
 
 func TestHasEquivalentSequenceToSuccess(t *testing.T) {
@@ -364,11 +449,138 @@ func TestNonOrderedTypes(t *testing.T) {
 	recorder := NewRecorder()
 
 	// Act.
-	That(recorder, 5.5).IsGreaterThan(5)
+	That(recorder, 5.5).IsGreaterThan("5")
+
+	// Assert.
+	assertFailed(t, recorder)
+	assertFailureMessage(t, recorder, "Expected two comparable types\nx: float64\ny: string")
+}
+
+func TestCrossKindOrderedComparisons(t *testing.T) {
+	type celsius float64
+	type count uint8
+
+	testCases := []struct {
+		x   interface{}
+		y   interface{}
+		cmp int
+	}{
+		{x: uint64(math.MaxUint64), y: 1, cmp: comparisonGreater},
+		{x: uint64(math.MaxUint64), y: int64(-1), cmp: comparisonGreater},
+		{x: ^uint(0), y: uint64(^uint(0) - 1), cmp: comparisonGreater},
+		{x: uintptr(1), y: int8(-1), cmp: comparisonGreater},
+		{x: 5.5, y: 5, cmp: comparisonGreater},
+		{x: 5, y: 5.0, cmp: comparisonEqual},
+		{x: int64(math.MaxInt64), y: float64(math.MaxInt64), cmp: comparisonLess},
+		{x: uint64(1 << 53), y: float32(1 << 53), cmp: comparisonEqual},
+		{x: uint64(1<<53 + 1), y: float64(1 << 53), cmp: comparisonGreater},
+		{x: math.Inf(1), y: uint64(math.MaxUint64), cmp: comparisonGreater},
+		{x: math.Inf(-1), y: math.Inf(-1), cmp: comparisonEqual},
+		{x: math.NaN(), y: 1, cmp: comparisonUnordered},
+		{x: celsius(21.5), y: 21, cmp: comparisonGreater},
+		{x: count(3), y: celsius(3), cmp: comparisonEqual},
+		{x: 2 * time.Second, y: time.Second, cmp: comparisonGreater},
+		{x: new(big.Int).Lsh(big.NewInt(1), 100), y: uint64(math.MaxUint64), cmp: comparisonGreater},
+		{x: *big.NewInt(-3), y: -3, cmp: comparisonEqual},
+		{x: big.NewRat(1, 3), y: 0.3333333333333333, cmp: comparisonGreater},
+		{x: big.NewFloat(2.5), y: big.NewRat(5, 2), cmp: comparisonEqual},
+		{x: new(big.Float).SetInf(true), y: -1e308, cmp: comparisonLess},
+	}
+
+	for _, testCase := range testCases {
+		rgt := NewRecorder()
+		rgte := NewRecorder()
+		rlt := NewRecorder()
+		rlte := NewRecorder()
+
+		That(rgt, testCase.x).IsGreaterThan(testCase.y)
+		That(rgte, testCase.x).IsGreaterThanOrEqualTo(testCase.y)
+		That(rlt, testCase.x).IsLessThan(testCase.y)
+		That(rlte, testCase.x).IsLessThanOrEqualTo(testCase.y)
+
+		if rgt.DidFail == (testCase.cmp == comparisonGreater) ||
+			rgte.DidFail == (testCase.cmp == comparisonGreater || testCase.cmp == comparisonEqual) ||
+			rlt.DidFail == (testCase.cmp == comparisonLess) ||
+			rlte.DidFail == (testCase.cmp == comparisonLess || testCase.cmp == comparisonEqual) {
+			t.Fatalf("Expected %v (%T) and %v (%T) to compare as %v", testCase.x, testCase.x, testCase.y, testCase.y, testCase.cmp)
+		}
+	}
+}
+
+func TestNilBigNumbersAreNotComparable(t *testing.T) {
+	// Arrange.
+	recorder := NewRecorder()
+
+	// Act.
+	That(recorder, (*big.Int)(nil)).IsGreaterThan(5)
+
+	// Assert.
+	assertFailed(t, recorder)
+	assertFailureMessage(t, recorder, "Expected two comparable types\nx: *big.Int\ny: int")
+}
+
+func TestNot(t *testing.T) {
+	// Arrange.
+	pass := NewRecorder()
+	fail := NewRecorder()
+
+	// Act.
+	That(pass, 4).Not().IsEqualTo(5)
+	That(fail, 4).Not().IsEqualTo(4)
+
+	// Assert.
+	assertPassed(t, pass)
+	assertHelperCount(t, pass, 2)
+
+	assertFailed(t, fail)
+	assertHelperCount(t, fail, 3)
+	assertFailureMessage(t, fail, "Expected 4 to not be equal to 4")
+}
+
+func TestNotRewritesFailureMessages(t *testing.T) {
+	testCases := []struct {
+		assert  func(a *Assertions)
+		message string
+	}{
+		{assert: func(a *Assertions) { a.Contains(2) }, message: "Expected []int{1, 2, 3} to not contain 2, but it did"},
+		{assert: func(a *Assertions) { a.HasLength(3) }, message: "Expected []int{1, 2, 3} to not have length 3, but it did"},
+		{assert: func(a *Assertions) { a.ContainsAny(3, 4) }, message: "Expected []int{1, 2, 3} to contain none of []interface{}{3, 4}, but it contained []interface{}{3}"},
+	}
+
+	for _, testCase := range testCases {
+		recorder := NewRecorder()
+		testCase.assert(That(recorder, []int{1, 2, 3}).Not())
+
+		assertFailed(t, recorder)
+		assertFailureMessage(t, recorder, testCase.message)
+	}
+}
+
+func TestNotTwiceRestoresAssertion(t *testing.T) {
+	// Arrange.
+	pass := NewRecorder()
+	fail := NewRecorder()
+
+	// Act.
+	That(pass, 4).Not().Not().IsEqualTo(4)
+	That(fail, 4).Not().Not().IsEqualTo(5)
+
+	// Assert.
+	assertPassed(t, pass)
+	assertFailed(t, fail)
+	assertFailureMessage(t, fail, "Expected 4 to be equal to 5")
+}
+
+func TestNotStillRejectsInvalidSubjects(t *testing.T) {
+	// Arrange.
+	recorder := NewRecorder()
+
+	// Act.
+	That(recorder, 5).Not().StartsWith("5")
 
 	// Assert.
 	assertFailed(t, recorder)
-	assertFailureMessage(t, recorder, "Expected two comparable types\nx: float64\ny: int")
+	assertFailureMessage(t, recorder, "Expected a string, []byte, error or fmt.Stringer, but was not\nx: int")
 }
 
 func assertFailed(t *testing.T, recorder *Recorder) {
@@ -389,7 +601,7 @@ func assertPassed(t *testing.T, recorder *Recorder) {
 
 func assertFailureMessage(t *testing.T, recorder *Recorder, format string, args ...interface{}) {
 	t.Helper()
-	message := fmt.Sprintf(format, args...)
+	message := sprintf(format, args...)
 
 	if recorder.FailMessage == "" {
 		t.Fatalf("expected failure message to be like '%v' but was empty", message)
@@ -0,0 +1,341 @@
+package test
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Contains fails the test if the subject, x, does not contain y.  Slices and
+// arrays must contain an element deeply equal to y, maps must contain a key
+// deeply equal to y, and strings must contain y as a substring.
+func (a *Assertions) Contains(y interface{}) {
+	a.t.Helper()
+
+	if m, failed := a.evaluate(contains(y)); failed {
+		formattedFailure(a, m.format, m.args...)
+	}
+}
+
+// DoesNotContain fails the test if the subject, x, contains y.  See Contains
+// for the meaning of containment for each kind of subject.
+func (a *Assertions) DoesNotContain(y interface{}) {
+	a.t.Helper()
+
+	if m, failed := a.Not().evaluate(contains(y)); failed {
+		formattedFailure(a, m.format, m.args...)
+	}
+}
+
+// ContainsAll fails the test if the subject, x, does not contain every one of
+// ys.  See Contains for the meaning of containment for each kind of subject.
+func (a *Assertions) ContainsAll(ys ...interface{}) {
+	a.t.Helper()
+
+	if m, failed := a.evaluate(containsAll(ys)); failed {
+		formattedFailure(a, m.format, m.args...)
+	}
+}
+
+// ContainsAny fails the test if the subject, x, contains none of ys.  See
+// Contains for the meaning of containment for each kind of subject.
+func (a *Assertions) ContainsAny(ys ...interface{}) {
+	a.t.Helper()
+
+	if m, failed := a.evaluate(containsAny(ys)); failed {
+		formattedFailure(a, m.format, m.args...)
+	}
+}
+
+// IsEmpty fails the test if the subject, x, is not an empty slice, array,
+// map, string or channel.  Channels are empty when nothing is buffered.
+func (a *Assertions) IsEmpty() {
+	a.t.Helper()
+
+	if m, failed := a.evaluate(isEmpty()); failed {
+		formattedFailure(a, m.format, m.args...)
+	}
+}
+
+// IsNotEmpty fails the test if the subject, x, is not a non-empty slice,
+// array, map, string or channel.
+func (a *Assertions) IsNotEmpty() {
+	a.t.Helper()
+
+	if m, failed := a.Not().evaluate(isEmpty()); failed {
+		formattedFailure(a, m.format, m.args...)
+	}
+}
+
+// HasLength fails the test if the subject, x, is not a slice, array, map,
+// string or channel of length n.  Strings are measured in bytes and channels
+// by the number of buffered elements.
+func (a *Assertions) HasLength(n int) {
+	a.t.Helper()
+
+	if m, failed := a.evaluate(hasLength(n)); failed {
+		formattedFailure(a, m.format, m.args...)
+	}
+}
+
+// HasSameElementsAs fails the test if the subject, x, and y do not contain
+// the same elements with the same multiplicities, regardless of order.  Only
+// for slices and arrays.  Elements are compared using deep equality.
+func (a *Assertions) HasSameElementsAs(y interface{}) {
+	a.t.Helper()
+
+	if m, failed := a.evaluate(sameElementsAs(y)); failed {
+		formattedFailure(a, m.format, m.args...)
+	}
+}
+
+func contains(y interface{}) predicate {
+	return func(x interface{}) outcome {
+		b, ok := baseContainsTest(x, y)
+		if !ok {
+			return outcome{
+				invalid:  true,
+				failure:  messagef("Expected a slice, array, map or string containing %v\nx: %s\ny: %s", y, typeNameFor(x), typeNameFor(y)),
+				negation: messagef("Expected a slice, array, map or string not containing %v\nx: %s\ny: %s", y, typeNameFor(x), typeNameFor(y)),
+			}
+		}
+
+		if !b {
+			if et, ok := baseElementType(x); ok && y != nil && !reflect.TypeOf(y).AssignableTo(et) {
+				return failed("Expected %v to contain %v, but it did not, and it cannot contain a value of a different type to its elements\nelements: %s\ny: %s", x, y, formatType(et), typeNameFor(y))
+			}
+
+			return failed("Expected %v to contain %v, but it did not", x, y)
+		}
+
+		return passed("Expected %v to not contain %v, but it did", x, y)
+	}
+}
+
+func containsAll(ys []interface{}) predicate {
+	return func(x interface{}) outcome {
+		var missing []interface{}
+		for _, y := range ys {
+			b, ok := baseContainsTest(x, y)
+			if !ok {
+				return invalid("Expected a slice, array, map or string containing %v\nx: %s\ny: %s", y, typeNameFor(x), typeNameFor(y))
+			}
+
+			if !b {
+				missing = append(missing, y)
+			}
+		}
+
+		if len(missing) > 0 {
+			return failed("Expected %v to contain all of %v, but it was missing %v", x, ys, missing)
+		}
+
+		return passed("Expected %v to not contain all of %v, but it did", x, ys)
+	}
+}
+
+func containsAny(ys []interface{}) predicate {
+	return func(x interface{}) outcome {
+		var present []interface{}
+		for _, y := range ys {
+			b, ok := baseContainsTest(x, y)
+			if !ok {
+				return invalid("Expected a slice, array, map or string containing %v\nx: %s\ny: %s", y, typeNameFor(x), typeNameFor(y))
+			}
+
+			if b {
+				present = append(present, y)
+			}
+		}
+
+		if len(present) == 0 {
+			return failed("Expected %v to contain any of %v, but it contained none of them", x, ys)
+		}
+
+		return passed("Expected %v to contain none of %v, but it contained %v", x, ys, present)
+	}
+}
+
+func isEmpty() predicate {
+	return withLength(func(x interface{}, n int) outcome {
+		if n != 0 {
+			return failed("Expected %v to be empty, but had length %v", x, n)
+		}
+
+		return passed("Expected subject to not be empty, but was\nx: %s", typeNameFor(x))
+	})
+}
+
+func hasLength(n int) predicate {
+	return withLength(func(x interface{}, l int) outcome {
+		if l != n {
+			return failed("Expected %v to have length %v, but had length %v", x, n, l)
+		}
+
+		return passed("Expected %v to not have length %v, but it did", x, n)
+	})
+}
+
+// withLength returns a predicate that applies p to the subject and its length.
+// The outcome is invalid if the subject has no length.
+func withLength(p func(x interface{}, n int) outcome) predicate {
+	return func(x interface{}) outcome {
+		n, ok := baseLengthValue(x)
+		if !ok {
+			return invalid("Expected a slice, array, map, string or channel, but was not\nx: %s", typeNameFor(x))
+		}
+
+		return p(x, n)
+	}
+}
+
+func sameElementsAs(y interface{}) predicate {
+	return func(x interface{}) outcome {
+		xv := reflect.ValueOf(x)
+		yv := reflect.ValueOf(y)
+
+		if !isSequence(xv) || !isSequence(yv) {
+			return invalid("Expected both subject and comparator to be slices or arrays\nx: %s\ny: %s", typeNameFor(x), typeNameFor(y))
+		}
+
+		var missing, extra []interface{}
+		var multiplicity []string
+
+		for _, count := range baseElementCounts(xv, yv) {
+			switch {
+			case count.x == 0:
+				missing = append(missing, count.value)
+			case count.y == 0:
+				extra = append(extra, count.value)
+			case count.x != count.y:
+				multiplicity = append(multiplicity, fmt.Sprintf("  %v: %v in subject, %v in comparator", count.value, count.x, count.y))
+			}
+		}
+
+		if len(missing) == 0 && len(extra) == 0 && len(multiplicity) == 0 {
+			return passed("Expected %v to not have the same elements as %v, but it did", x, y)
+		}
+
+		sb := &strings.Builder{}
+		if len(missing) > 0 {
+			fmt.Fprintf(sb, "\nmissing: %v", missing)
+		}
+
+		if len(extra) > 0 {
+			fmt.Fprintf(sb, "\nextra: %v", extra)
+		}
+
+		if len(multiplicity) > 0 {
+			fmt.Fprintf(sb, "\ndifferent multiplicity:\n%v", strings.Join(multiplicity, "\n"))
+		}
+
+		return failed("Expected %v to have the same elements as %v\n%s", x, y, sb.String())
+	}
+}
+
+func baseContainsTest(x interface{}, y interface{}) (bool, bool) {
+	xv := reflect.ValueOf(x)
+	yv := reflect.ValueOf(y)
+
+	switch xv.Kind() {
+	case reflect.String:
+		if r, ok := y.(rune); ok {
+			return strings.ContainsRune(xv.String(), r), true
+		}
+
+		if yv.Kind() == reflect.String {
+			return strings.Contains(xv.String(), yv.String()), true
+		}
+
+		return false, false
+
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < xv.Len(); i++ {
+			if len(deepDiff(xv.Index(i).Interface(), y)) == 0 {
+				return true, true
+			}
+		}
+
+		return false, true
+
+	case reflect.Map:
+		for _, key := range xv.MapKeys() {
+			if len(deepDiff(key.Interface(), y)) == 0 {
+				return true, true
+			}
+		}
+
+		return false, true
+	}
+
+	return false, false
+}
+
+// baseElementType returns the type of the elements of a slice or array, or the
+// type of the keys of a map, which are the values it can contain.
+func baseElementType(x interface{}) (reflect.Type, bool) {
+	xt := reflect.TypeOf(x)
+	if xt == nil {
+		return nil, false
+	}
+
+	switch xt.Kind() {
+	case reflect.Slice, reflect.Array:
+		return xt.Elem(), true
+	case reflect.Map:
+		return xt.Key(), true
+	}
+
+	return nil, false
+}
+
+func baseLengthValue(x interface{}) (int, bool) {
+	xv := reflect.ValueOf(x)
+
+	switch xv.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map, reflect.String, reflect.Chan:
+		return xv.Len(), true
+	}
+
+	return 0, false
+}
+
+// elementCount records how many times an element occurs in each of two
+// sequences.
+type elementCount struct {
+	value interface{}
+	x     int
+	y     int
+}
+
+// baseElementCounts groups the elements of xv and yv into classes of deeply
+// equal values, in order of first appearance, and counts each class.
+func baseElementCounts(xv reflect.Value, yv reflect.Value) []*elementCount {
+	var counts []*elementCount
+
+	find := func(value interface{}) *elementCount {
+		for _, count := range counts {
+			if len(deepDiff(count.value, value)) == 0 {
+				return count
+			}
+		}
+
+		count := &elementCount{value: value}
+		counts = append(counts, count)
+		return count
+	}
+
+	for i := 0; i < xv.Len(); i++ {
+		find(xv.Index(i).Interface()).x++
+	}
+
+	for i := 0; i < yv.Len(); i++ {
+		find(yv.Index(i).Interface()).y++
+	}
+
+	return counts
+}
+
+func isSequence(v reflect.Value) bool {
+	return v.Kind() == reflect.Slice || v.Kind() == reflect.Array
+}
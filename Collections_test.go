@@ -0,0 +1,239 @@
+package test
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestContains(t *testing.T) {
+	type point struct {
+		X int
+		Y int
+	}
+
+	testCases := []struct {
+		x        interface{}
+		y        interface{}
+		contains bool
+		ok       bool
+	}{
+		{x: []int{1, 2, 3}, y: 2, contains: true, ok: true},
+		{x: []int{1, 2, 3}, y: 4, contains: false, ok: true},
+		{x: []int{1, 2, 3}, y: int64(2), contains: false, ok: true},
+		{x: [2]string{"a", "b"}, y: "b", contains: true, ok: true},
+		{x: []point{{1, 2}}, y: point{1, 2}, contains: true, ok: true},
+		{x: []*point{{1, 2}}, y: &point{1, 2}, contains: true, ok: true},
+		{x: map[string]int{"a": 1}, y: "a", contains: true, ok: true},
+		{x: map[string]int{"a": 1}, y: 1, contains: false, ok: true},
+		{x: "Hello World", y: "lo W", contains: true, ok: true},
+		{x: "Hello World", y: 'W', contains: true, ok: true},
+		{x: "Hello World", y: "Bye", contains: false, ok: true},
+		{x: identifier("user-1"), y: "-", contains: true, ok: true},
+		{x: "user-1", y: identifier("user"), contains: true, ok: true},
+		{x: identifier("user-1"), y: 'u', contains: true, ok: true},
+		{x: "Hello World", y: 5, ok: false},
+		{x: 5, y: 5, ok: false},
+		{x: make(chan int, 1), y: 5, ok: false},
+	}
+
+	for _, testCase := range testCases {
+		contains := NewRecorder()
+		doesNotContain := NewRecorder()
+
+		That(contains, testCase.x).Contains(testCase.y)
+		That(doesNotContain, testCase.x).DoesNotContain(testCase.y)
+
+		switch {
+		case !testCase.ok:
+			assertFailed(t, contains)
+			assertFailed(t, doesNotContain)
+			assertFailureMessage(t, contains, "Expected a slice, array, map or string containing")
+		case testCase.contains:
+			assertPassed(t, contains)
+			assertFailed(t, doesNotContain)
+			assertFailureMessage(t, doesNotContain, "Expected %v to not contain %v, but it did", testCase.x, testCase.y)
+		default:
+			assertFailed(t, contains)
+			assertPassed(t, doesNotContain)
+			assertFailureMessage(t, contains, "Expected %v to contain %v, but it did not", testCase.x, testCase.y)
+		}
+	}
+}
+
+type identifier string
+
+func TestContainsReportsMismatchedElementType(t *testing.T) {
+	testCases := []struct {
+		x       interface{}
+		y       interface{}
+		message string
+	}{
+		{x: []int64{1}, y: 1, message: "Expected []int64{1} to contain 1, but it did not, and it cannot contain a value of a different type to its elements\nelements: int64\ny: int"},
+		{x: map[identifier]bool{"a": true}, y: "a", message: "cannot contain a value of a different type to its elements\nelements: test.identifier\ny: string"},
+		{x: []int64{1}, y: int64(2), message: "Expected []int64{1} to contain int64(2), but it did not"},
+		{x: []interface{}{1}, y: 2, message: "Expected []interface{}{1} to contain 2, but it did not"},
+	}
+
+	for _, testCase := range testCases {
+		recorder := NewRecorder()
+		That(recorder, testCase.x).Contains(testCase.y)
+
+		assertFailed(t, recorder)
+		assertFailureMessage(t, recorder, testCase.message)
+
+		if !strings.HasSuffix(strings.TrimSpace(recorder.FailMessage), testCase.message) {
+			t.Fatalf("Expected failure message to end with '%v' but was '%v'", testCase.message, recorder.FailMessage)
+		}
+	}
+}
+
+func TestContainsAll(t *testing.T) {
+	// Arrange.
+	x := []string{"a", "b", "c"}
+
+	// Act.
+	pass := NewRecorder()
+	fail := NewRecorder()
+
+	That(pass, x).ContainsAll("a", "c")
+	That(fail, x).ContainsAll("a", "d", "e")
+
+	// Assert.
+	assertPassed(t, pass)
+	assertHelperCount(t, pass, 2)
+
+	assertFailed(t, fail)
+	assertHelperCount(t, fail, 3)
+	assertFailureMessage(t, fail, "Expected []string{\"a\", \"b\", \"c\"} to contain all of []interface{}{\"a\", \"d\", \"e\"}, but it was missing []interface{}{\"d\", \"e\"}")
+}
+
+func TestContainsAny(t *testing.T) {
+	// Arrange.
+	x := map[int]string{1: "one", 2: "two"}
+
+	// Act.
+	pass := NewRecorder()
+	fail := NewRecorder()
+
+	That(pass, x).ContainsAny(5, 2)
+	That(fail, x).ContainsAny(5, 6)
+
+	// Assert.
+	assertPassed(t, pass)
+
+	assertFailed(t, fail)
+	assertFailureMessage(t, fail, "Expected map[int]string{1: \"one\", 2: \"two\"} to contain any of []interface{}{5, 6}, but it contained none of them")
+}
+
+func TestLengthAssertions(t *testing.T) {
+	buffered := make(chan int, 3)
+	buffered <- 1
+	buffered <- 2
+
+	testCases := []struct {
+		x      interface{}
+		length int
+		ok     bool
+	}{
+		{x: []int{}, length: 0, ok: true},
+		{x: []int(nil), length: 0, ok: true},
+		{x: []int{1, 2}, length: 2, ok: true},
+		{x: [3]int{}, length: 3, ok: true},
+		{x: map[string]int{"a": 1}, length: 1, ok: true},
+		{x: "", length: 0, ok: true},
+		{x: "Hello", length: 5, ok: true},
+		{x: make(chan int), length: 0, ok: true},
+		{x: buffered, length: 2, ok: true},
+		{x: 5, ok: false},
+		{x: nil, ok: false},
+	}
+
+	for _, testCase := range testCases {
+		isEmpty := NewRecorder()
+		isNotEmpty := NewRecorder()
+		hasLength := NewRecorder()
+		hasWrongLength := NewRecorder()
+
+		That(isEmpty, testCase.x).IsEmpty()
+		That(isNotEmpty, testCase.x).IsNotEmpty()
+		That(hasLength, testCase.x).HasLength(testCase.length)
+		That(hasWrongLength, testCase.x).HasLength(testCase.length + 1)
+
+		if !testCase.ok {
+			assertFailed(t, isEmpty)
+			assertFailed(t, isNotEmpty)
+			assertFailed(t, hasLength)
+			assertFailureMessage(t, hasLength, "Expected a slice, array, map, string or channel, but was not")
+			continue
+		}
+
+		if testCase.length == 0 {
+			assertPassed(t, isEmpty)
+			assertFailed(t, isNotEmpty)
+		} else {
+			assertFailed(t, isEmpty)
+			assertFailureMessage(t, isEmpty, "to be empty, but had length %v", testCase.length)
+			assertPassed(t, isNotEmpty)
+		}
+
+		assertPassed(t, hasLength)
+		assertFailed(t, hasWrongLength)
+		assertFailureMessage(t, hasWrongLength, "to have length %v, but had length %v", testCase.length+1, testCase.length)
+	}
+}
+
+func TestHasSameElementsAs(t *testing.T) {
+	testCases := []struct {
+		x    interface{}
+		y    interface{}
+		pass bool
+	}{
+		{x: []int{1, 2, 3}, y: []int{3, 1, 2}, pass: true},
+		{x: []int{1, 1, 2}, y: [3]int{1, 2, 1}, pass: true},
+		{x: []int{}, y: []int(nil), pass: true},
+		{x: [][]int{{1}, {2}}, y: [][]int{{2}, {1}}, pass: true},
+		{x: []int{1, 2}, y: []int{1, 2, 3}, pass: false},
+		{x: []int{1, 1, 2}, y: []int{1, 2, 2}, pass: false},
+	}
+
+	for _, testCase := range testCases {
+		recorder := NewRecorder()
+		That(recorder, testCase.x).HasSameElementsAs(testCase.y)
+
+		if !testCase.pass {
+			assertFailed(t, recorder)
+			assertFailureMessage(t, recorder, "Expected %v to have the same elements as %v", testCase.x, testCase.y)
+			assertHelperCount(t, recorder, 3)
+		} else {
+			assertPassed(t, recorder)
+			assertHelperCount(t, recorder, 2)
+		}
+	}
+}
+
+func TestHasSameElementsAsReportsDifferences(t *testing.T) {
+	// Arrange.
+	x := []string{"a", "b", "b", "c", "x"}
+	y := []string{"a", "b", "c", "c", "d"}
+
+	// Act.
+	recorder := NewRecorder()
+
+	That(recorder, x).HasSameElementsAs(y)
+
+	// Assert.
+	assertFailed(t, recorder)
+	assertFailureMessage(t, recorder, "\nmissing: [d]\nextra: [x]\ndifferent multiplicity:\n  b: 2 in subject, 1 in comparator\n  c: 1 in subject, 2 in comparator")
+}
+
+func TestHasSameElementsAsExpectsSequences(t *testing.T) {
+	// Arrange.
+	recorder := NewRecorder()
+
+	// Act.
+	That(recorder, map[int]int{}).HasSameElementsAs([]int{})
+
+	// Assert.
+	assertFailed(t, recorder)
+	assertFailureMessage(t, recorder, "Expected both subject and comparator to be slices or arrays\nx: map[int]int\ny: []int")
+}
@@ -0,0 +1,221 @@
+package test
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// deepDiff recursively compares x and y and returns a description of every
+// path at which they differ.  An empty result means x and y are deeply equal.
+func deepDiff(x interface{}, y interface{}) []string {
+	d := &differ{visited: make(map[visit]bool)}
+	d.compare("", reflect.ValueOf(x), reflect.ValueOf(y))
+
+	return d.diffs
+}
+
+// visit records a pair of references that are currently being compared, so
+// that cyclic data structures terminate.  Slices that share a backing array
+// are only the same reference if they also have the same length.
+type visit struct {
+	x    uintptr
+	y    uintptr
+	xlen int
+	ylen int
+	typ  reflect.Type
+}
+
+type differ struct {
+	visited map[visit]bool
+	diffs   []string
+}
+
+func (d *differ) report(path string, format string, args ...interface{}) {
+	if path == "" {
+		path = "."
+	}
+
+	d.diffs = append(d.diffs, fmt.Sprintf("%v: %v", path, fmt.Sprintf(format, args...)))
+}
+
+func (d *differ) compare(path string, xv reflect.Value, yv reflect.Value) {
+	if !xv.IsValid() || !yv.IsValid() {
+		if xv.IsValid() != yv.IsValid() {
+			d.report(path, "%v != %v", formatDiffValue(xv), formatDiffValue(yv))
+		}
+		return
+	}
+
+	if xv.Type() != yv.Type() {
+		d.report(path, "type %v != type %v", xv.Type(), yv.Type())
+		return
+	}
+
+	if v, ok := visitFor(xv, yv); ok {
+		if d.visited[v] {
+			return
+		}
+
+		d.visited[v] = true
+		defer delete(d.visited, v)
+	}
+
+	switch xv.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if xv.IsNil() || yv.IsNil() {
+			if xv.IsNil() != yv.IsNil() {
+				d.report(path, "%v != %v", formatDiffValue(xv), formatDiffValue(yv))
+			}
+			return
+		}
+
+		d.compare(path, xv.Elem(), yv.Elem())
+
+	case reflect.Struct:
+		for i := 0; i < xv.NumField(); i++ {
+			d.compare(fmt.Sprintf("%v.%v", path, xv.Type().Field(i).Name), xv.Field(i), yv.Field(i))
+		}
+
+	case reflect.Slice:
+		if xv.IsNil() != yv.IsNil() {
+			d.report(path, "%v != %v", formatDiffValue(xv), formatDiffValue(yv))
+			return
+		}
+
+		d.compareSequence(path, xv, yv)
+
+	case reflect.Array:
+		d.compareSequence(path, xv, yv)
+
+	case reflect.Map:
+		if xv.IsNil() != yv.IsNil() {
+			d.report(path, "%v != %v", formatDiffValue(xv), formatDiffValue(yv))
+			return
+		}
+
+		d.compareMap(path, xv, yv)
+
+	case reflect.Func:
+		if !xv.IsNil() || !yv.IsNil() {
+			d.report(path, "non-nil functions are never equal")
+		}
+
+	case reflect.Chan, reflect.UnsafePointer:
+		if xv.Pointer() != yv.Pointer() {
+			d.report(path, "%v != %v", formatDiffValue(xv), formatDiffValue(yv))
+		}
+
+	default:
+		if !baseScalarEqualityTest(xv, yv) {
+			d.report(path, "%v != %v", formatDiffValue(xv), formatDiffValue(yv))
+		}
+	}
+}
+
+// visitFor returns the visit for the reference pair xv, yv, or false if they
+// are not both non-nil references.  A pair that is already being compared
+// further up the stack is part of a cycle.
+func visitFor(xv reflect.Value, yv reflect.Value) (visit, bool) {
+	switch xv.Kind() {
+	case reflect.Ptr, reflect.Map, reflect.Slice:
+	default:
+		return visit{}, false
+	}
+
+	if xv.IsNil() || yv.IsNil() {
+		return visit{}, false
+	}
+
+	v := visit{x: xv.Pointer(), y: yv.Pointer(), typ: xv.Type()}
+	if xv.Kind() == reflect.Slice {
+		v.xlen, v.ylen = xv.Len(), yv.Len()
+	}
+
+	return v, true
+}
+
+func (d *differ) compareSequence(path string, xv reflect.Value, yv reflect.Value) {
+	n := xv.Len()
+	if yv.Len() > n {
+		n = yv.Len()
+	}
+
+	for i := 0; i < n; i++ {
+		elementPath := fmt.Sprintf("%v[%v]", path, i)
+
+		switch {
+		case i >= xv.Len():
+			d.report(elementPath, "<missing> != %v", formatDiffValue(yv.Index(i)))
+		case i >= yv.Len():
+			d.report(elementPath, "%v != <missing>", formatDiffValue(xv.Index(i)))
+		default:
+			d.compare(elementPath, xv.Index(i), yv.Index(i))
+		}
+	}
+}
+
+func (d *differ) compareMap(path string, xv reflect.Value, yv reflect.Value) {
+	keys := xv.MapKeys()
+	for _, key := range yv.MapKeys() {
+		if !xv.MapIndex(key).IsValid() {
+			keys = append(keys, key)
+		}
+	}
+
+	sortValues(keys)
+
+	for _, key := range keys {
+		elementPath := fmt.Sprintf("%v[%v]", path, formatMapKey(key))
+		xe := xv.MapIndex(key)
+		ye := yv.MapIndex(key)
+
+		switch {
+		case !xe.IsValid():
+			d.report(elementPath, "<missing> != %v", formatDiffValue(ye))
+		case !ye.IsValid():
+			d.report(elementPath, "%v != <missing>", formatDiffValue(xe))
+		default:
+			d.compare(elementPath, xe, ye)
+		}
+	}
+}
+
+// baseScalarEqualityTest compares two values of the same scalar kind without
+// calling Interface(), so that unexported struct fields can be compared.
+func baseScalarEqualityTest(xv reflect.Value, yv reflect.Value) bool {
+	switch xv.Kind() {
+	case reflect.Bool:
+		return xv.Bool() == yv.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return xv.Int() == yv.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return xv.Uint() == yv.Uint()
+	case reflect.Float32, reflect.Float64:
+		return xv.Float() == yv.Float()
+	case reflect.Complex64, reflect.Complex128:
+		return xv.Complex() == yv.Complex()
+	case reflect.String:
+		return xv.String() == yv.String()
+	}
+
+	return false
+}
+
+func formatDiffValue(v reflect.Value) string {
+	return formatReflectValue(v, false)
+}
+
+func formatMapKey(v reflect.Value) string {
+	if v.Kind() == reflect.String {
+		return fmt.Sprintf("%q", v.String())
+	}
+
+	return formatDiffValue(v)
+}
+
+func sortValues(values []reflect.Value) {
+	sort.SliceStable(values, func(i, j int) bool {
+		return fmt.Sprintf("%v", values[i]) < fmt.Sprintf("%v", values[j])
+	})
+}
@@ -0,0 +1,54 @@
+package test
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDeepDiff(t *testing.T) {
+	type node struct {
+		Value int
+		Next  *node
+		label string
+	}
+
+	cyclicX := &node{Value: 1}
+	cyclicX.Next = cyclicX
+	cyclicY := &node{Value: 1}
+	cyclicY.Next = cyclicY
+
+	shared := []int{1, 2}
+	one := []int{1}
+	two := []int{2}
+
+	testCases := []struct {
+		x     interface{}
+		y     interface{}
+		diffs []string
+	}{
+		{x: 1, y: 1, diffs: nil},
+		{x: 1, y: 2, diffs: []string{".: 1 != 2"}},
+		{x: "a", y: "b", diffs: []string{`.: "a" != "b"`}},
+		{x: 1, y: int64(1), diffs: []string{".: type int != type int64"}},
+		{x: nil, y: 1, diffs: []string{".: nil != 1"}},
+		{x: []int{1, 2}, y: []int{1, 2, 3}, diffs: []string{"[2]: <missing> != 3"}},
+		{x: []int(nil), y: []int{}, diffs: []string{".: []int(nil) != []int{}"}},
+		{x: [2]int{1, 2}, y: [2]int{2, 1}, diffs: []string{"[0]: 1 != 2", "[1]: 2 != 1"}},
+		{x: map[string]int{"a": 1, "b": 2}, y: map[string]int{"a": 1, "c": 3}, diffs: []string{`["b"]: 2 != <missing>`, `["c"]: <missing> != 3`}},
+		{x: node{label: "x"}, y: node{label: "y"}, diffs: []string{`.label: "x" != "y"`}},
+		{x: &node{Value: 1}, y: &node{Value: 2}, diffs: []string{".Value: 1 != 2"}},
+		{x: &node{Value: 1}, y: (*node)(nil), diffs: []string{`.: &test.node{Value: 1, Next: (*test.node)(nil), label: ""} != (*test.node)(nil)`}},
+		{x: cyclicX, y: cyclicY, diffs: nil},
+		{x: [][]int{shared[:1], shared[:1]}, y: [][]int{shared[:1], shared[:2]}, diffs: []string{"[1][1]: <missing> != 2"}},
+		{x: [][]int{one, one}, y: [][]int{two, two}, diffs: []string{"[0][0]: 1 != 2", "[1][0]: 1 != 2"}},
+		{x: []interface{}{1, "a"}, y: []interface{}{1, 2}, diffs: []string{"[1]: type string != type int"}},
+	}
+
+	for _, testCase := range testCases {
+		diffs := deepDiff(testCase.x, testCase.y)
+
+		if !reflect.DeepEqual(diffs, testCase.diffs) {
+			t.Fatalf("Expected deepDiff(%v, %v) to be %q but was %q", testCase.x, testCase.y, testCase.diffs, diffs)
+		}
+	}
+}
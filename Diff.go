@@ -0,0 +1,350 @@
+package test
+
+import (
+	"fmt"
+	"strings"
+)
+
+// diffContextLines is the number of unchanged lines shown either side of a
+// change in a unified diff.
+const diffContextLines = 3
+
+type editKind int
+
+const (
+	editEqual editKind = iota
+	editDelete
+	editInsert
+)
+
+// edit is a single step in an edit script that transforms sequence a into
+// sequence b.  For editEqual both indices are set, for editDelete only ai is
+// meaningful, and for editInsert only bi is meaningful.
+type edit struct {
+	kind editKind
+	ai   int
+	bi   int
+}
+
+// maxDiffEdits bounds the length of the edit scripts that myersDiff will
+// search for.  The search takes time and memory proportional to the square of
+// the length of the script, so inputs that differ by more are not diffed.
+const maxDiffEdits = 1000
+
+// myersDiff computes a shortest edit script between two sequences of length n
+// and m using Myers' O(ND) algorithm.  equal reports whether a[i] == b[j].  It
+// returns false if the script would be longer than maxDiffEdits.
+func myersDiff(n int, m int, equal func(i, j int) bool) ([]edit, bool) {
+	max := n + m
+	if max > maxDiffEdits {
+		max = maxDiffEdits
+	}
+
+	offset := max + 1
+	v := make([]int, 2*max+3)
+
+	// trace records, for each step d, the furthest reaching x on the diagonals
+	// -d through d before the step, which are the only ones read while
+	// backtracking from it.
+	var trace [][]int
+	found := false
+
+search:
+	for d := 0; d <= max; d++ {
+		trace = append(trace, append([]int(nil), v[offset-d:offset+d+1]...))
+
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+
+			y := x - k
+			for x < n && y < m && equal(x, y) {
+				x++
+				y++
+			}
+
+			v[offset+k] = x
+			if x >= n && y >= m {
+				found = true
+				break search
+			}
+		}
+	}
+
+	if !found {
+		return nil, false
+	}
+
+	var edits []edit
+	x, y := n, m
+
+	for d := len(trace) - 1; d >= 0; d-- {
+		k := x - y
+		prevX, prevY := 0, 0
+
+		if d > 0 {
+			// The diagonal k is stored at index k+d of the window for step d.
+			w := trace[d]
+
+			var prevK int
+			if k == -d || (k != d && w[k-1+d] < w[k+1+d]) {
+				prevK = k + 1
+			} else {
+				prevK = k - 1
+			}
+
+			prevX = w[prevK+d]
+			prevY = prevX - prevK
+		}
+
+		for x > prevX && y > prevY {
+			x--
+			y--
+			edits = append(edits, edit{kind: editEqual, ai: x, bi: y})
+		}
+
+		if d > 0 {
+			if x == prevX {
+				y--
+				edits = append(edits, edit{kind: editInsert, ai: -1, bi: y})
+			} else {
+				x--
+				edits = append(edits, edit{kind: editDelete, ai: x, bi: -1})
+			}
+		}
+	}
+
+	for i, j := 0, len(edits)-1; i < j; i, j = i+1, j-1 {
+		edits[i], edits[j] = edits[j], edits[i]
+	}
+
+	return edits, true
+}
+
+// replacementFor returns the edit script that deletes every element of a
+// sequence of length n and then inserts every element of one of length m.
+func replacementFor(n int, m int) []edit {
+	edits := make([]edit, 0, n+m)
+	for i := 0; i < n; i++ {
+		edits = append(edits, edit{kind: editDelete, ai: i, bi: -1})
+	}
+
+	for j := 0; j < m; j++ {
+		edits = append(edits, edit{kind: editInsert, ai: -1, bi: j})
+	}
+
+	return edits
+}
+
+// stringDiffFor returns a unified diff between x and y when both are strings
+// and at least one of them spans multiple lines.  Otherwise, it returns an
+// empty string.
+func stringDiffFor(x interface{}, y interface{}) string {
+	xs, ok1 := x.(string)
+	ys, ok2 := y.(string)
+	if !ok1 || !ok2 || xs == ys {
+		return ""
+	}
+
+	if !strings.Contains(xs, "\n") && !strings.Contains(ys, "\n") {
+		return ""
+	}
+
+	// Strings that differ too much to diff are printed as they are instead.
+	diff, ok := lineDiff(xs, ys)
+	if !ok {
+		return ""
+	}
+
+	return diff
+}
+
+// unifiedDiff renders a line-based unified diff that transforms a into b.
+// Within paired changed lines, removed characters are marked with [-...-] and
+// added characters with {+...+}.  If a and b differ by more than
+// maxDiffEdits lines, the diff replaces every line of a with every line of b.
+func unifiedDiff(a string, b string) string {
+	diff, _ := lineDiff(a, b)
+
+	return diff
+}
+
+// lineDiff renders the diff for unifiedDiff, and returns false if it had to
+// replace every line because a and b differ by too much.
+func lineDiff(a string, b string) (string, bool) {
+	al := strings.Split(a, "\n")
+	bl := strings.Split(b, "\n")
+	edits, ok := myersDiff(len(al), len(bl), func(i, j int) bool {
+		return al[i] == bl[j]
+	})
+
+	if !ok {
+		edits = replacementFor(len(al), len(bl))
+	}
+
+	sb := &strings.Builder{}
+	sb.WriteString("--- x\n+++ y\n")
+
+	for _, hunk := range hunksFor(edits) {
+		writeHunk(sb, hunk, al, bl)
+	}
+
+	return strings.TrimSuffix(sb.String(), "\n"), ok
+}
+
+// hunksFor splits an edit script into groups of changes, each surrounded by
+// at most diffContextLines of unchanged lines.
+func hunksFor(edits []edit) [][]edit {
+	var hunks [][]edit
+	start, end := -1, -1
+
+	for i, e := range edits {
+		if e.kind == editEqual {
+			continue
+		}
+
+		lo := i - diffContextLines
+		if lo < 0 {
+			lo = 0
+		}
+
+		hi := i + diffContextLines + 1
+		if hi > len(edits) {
+			hi = len(edits)
+		}
+
+		if start >= 0 && lo > end {
+			hunks = append(hunks, edits[start:end])
+			start = -1
+		}
+
+		if start < 0 {
+			start = lo
+		}
+
+		end = hi
+	}
+
+	if start >= 0 {
+		hunks = append(hunks, edits[start:end])
+	}
+
+	return hunks
+}
+
+func writeHunk(sb *strings.Builder, hunk []edit, al []string, bl []string) {
+	aStart, aCount, bStart, bCount := -1, 0, -1, 0
+
+	for _, e := range hunk {
+		if e.kind != editInsert {
+			if aStart < 0 {
+				aStart = e.ai
+			}
+			aCount++
+		}
+
+		if e.kind != editDelete {
+			if bStart < 0 {
+				bStart = e.bi
+			}
+			bCount++
+		}
+	}
+
+	fmt.Fprintf(sb, "@@ -%v +%v @@\n", hunkRange(aStart, aCount), hunkRange(bStart, bCount))
+
+	for i := 0; i < len(hunk); {
+		if hunk[i].kind == editEqual {
+			fmt.Fprintf(sb, " %v\n", al[hunk[i].ai])
+			i++
+			continue
+		}
+
+		var deleted, inserted []string
+		for ; i < len(hunk) && hunk[i].kind == editDelete; i++ {
+			deleted = append(deleted, al[hunk[i].ai])
+		}
+
+		for ; i < len(hunk) && hunk[i].kind == editInsert; i++ {
+			inserted = append(inserted, bl[hunk[i].bi])
+		}
+
+		for j := range deleted {
+			if j < len(inserted) {
+				deleted[j], inserted[j] = highlightChanges(deleted[j], inserted[j])
+			}
+		}
+
+		for _, line := range deleted {
+			fmt.Fprintf(sb, "-%v\n", line)
+		}
+
+		for _, line := range inserted {
+			fmt.Fprintf(sb, "+%v\n", line)
+		}
+	}
+}
+
+// hunkRange formats the start,count pair for one side of a hunk header.
+func hunkRange(start int, count int) string {
+	return fmt.Sprintf("%v,%v", start+1, count)
+}
+
+// highlightChanges performs a character-level diff between a and b and marks
+// the characters removed from a and added to b.  Lines that differ by more
+// than maxDiffEdits characters are returned unmarked.
+func highlightChanges(a string, b string) (string, string) {
+	ar := []rune(a)
+	br := []rune(b)
+	edits, ok := myersDiff(len(ar), len(br), func(i, j int) bool {
+		return ar[i] == br[j]
+	})
+
+	if !ok {
+		return a, b
+	}
+
+	as := &strings.Builder{}
+	bs := &strings.Builder{}
+	var current editKind = editEqual
+
+	closeRun := func() {
+		switch current {
+		case editDelete:
+			as.WriteString("-]")
+		case editInsert:
+			bs.WriteString("+}")
+		}
+	}
+
+	for _, e := range edits {
+		if e.kind != current {
+			closeRun()
+			switch e.kind {
+			case editDelete:
+				as.WriteString("[-")
+			case editInsert:
+				bs.WriteString("{+")
+			}
+			current = e.kind
+		}
+
+		switch e.kind {
+		case editEqual:
+			as.WriteRune(ar[e.ai])
+			bs.WriteRune(br[e.bi])
+		case editDelete:
+			as.WriteRune(ar[e.ai])
+		case editInsert:
+			bs.WriteRune(br[e.bi])
+		}
+	}
+
+	closeRun()
+
+	return as.String(), bs.String()
+}
@@ -0,0 +1,115 @@
+package test
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func TestUnifiedDiff(t *testing.T) {
+	testCases := []struct {
+		a    string
+		b    string
+		diff string
+	}{
+		{
+			a:    "a\nb\nc",
+			b:    "a\nx\nc",
+			diff: "--- x\n+++ y\n@@ -1,3 +1,3 @@\n a\n-[-b-]\n+{+x+}\n c",
+		},
+		{
+			a:    "SELECT id\nFROM users\nWHERE id = 1",
+			b:    "SELECT id\nFROM users\nWHERE id = 2\nLIMIT 1",
+			diff: "--- x\n+++ y\n@@ -1,3 +1,4 @@\n SELECT id\n FROM users\n-WHERE id = [-1-]\n+WHERE id = {+2+}\n+LIMIT 1",
+		},
+		{
+			a:    "1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\n12",
+			b:    "0\n1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11",
+			diff: "--- x\n+++ y\n@@ -1,3 +1,4 @@\n+0\n 1\n 2\n 3\n@@ -9,4 +10,3 @@\n 9\n 10\n 11\n-12",
+		},
+	}
+
+	for _, testCase := range testCases {
+		diff := unifiedDiff(testCase.a, testCase.b)
+
+		if diff != testCase.diff {
+			t.Fatalf("Expected diff to be\n\n%v\n\nbut was\n\n%v", testCase.diff, diff)
+		}
+	}
+}
+
+func TestHighlightChanges(t *testing.T) {
+	// Arrange.
+	a := "the quick brown fox"
+	b := "the quick red fox"
+
+	// Act.
+	ha, hb := highlightChanges(a, b)
+
+	// Assert.
+	if ha != "the quick [-b-]r[-own-] fox" {
+		t.Fatalf("Unexpected highlighting of removed characters '%v'", ha)
+	}
+
+	if hb != "the quick r{+ed+} fox" {
+		t.Fatalf("Unexpected highlighting of added characters '%v'", hb)
+	}
+}
+
+func TestStringDiffForIgnoresSingleLineStrings(t *testing.T) {
+	testCases := []struct {
+		x interface{}
+		y interface{}
+	}{
+		{x: "Hello", y: "Hellp"},
+		{x: "a\nb", y: "a\nb"},
+		{x: "a\nb", y: 5},
+	}
+
+	for _, testCase := range testCases {
+		if diff := stringDiffFor(testCase.x, testCase.y); diff != "" {
+			t.Fatalf("Expected no diff for %q and %q but was\n\n%v", testCase.x, testCase.y, diff)
+		}
+	}
+}
+
+func TestDiffOfLargeDifferentInputs(t *testing.T) {
+	// Arrange.
+	var al, bl []string
+	for i := 0; i < 10000; i++ {
+		al = append(al, fmt.Sprintf("a%v", i))
+		bl = append(bl, fmt.Sprintf("b%v", i))
+	}
+
+	a := strings.Join(al, "\n")
+	b := strings.Join(bl, "\n")
+	line := strings.Repeat("x", 100000)
+
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+
+	// Act.
+	diff := unifiedDiff(a, b)
+	plain := stringDiffFor(a, b)
+	ha, hb := highlightChanges(line, strings.Repeat("y", 100000))
+
+	runtime.ReadMemStats(&after)
+
+	// Assert.
+	if allocated := after.TotalAlloc - before.TotalAlloc; allocated > 64<<20 {
+		t.Fatalf("Expected diffing large inputs to allocate at most 64 MiB, but it allocated %v MiB", allocated>>20)
+	}
+
+	if !strings.HasPrefix(diff, "--- x\n+++ y\n@@ -1,10000 +1,10000 @@\n-[-a-]0\n-[-a-]1\n") || !strings.HasSuffix(diff, "\n+{+b+}9998\n+{+b+}9999") {
+		t.Fatalf("Expected every line to be replaced, but the diff was\n\n%v", diff[:200])
+	}
+
+	if plain != "" {
+		t.Fatalf("Expected no diff for strings that differ too much, but there was one")
+	}
+
+	if ha != line || hb != strings.Repeat("y", 100000) {
+		t.Fatalf("Expected lines that differ too much to be left unmarked")
+	}
+}
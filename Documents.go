@@ -0,0 +1,158 @@
+package test
+
+import (
+	"reflect"
+	"strings"
+	"time"
+)
+
+// Format is a text format for documents, such as YAML or TOML, that can be
+// compared with EqualsDocument.  EqualsYAML and EqualsTOML compare documents
+// in the formats that this package provides.
+type Format interface {
+	// Name returns the name of the format used in failure messages, such as
+	// "YAML".
+	Name() string
+
+	// Parse decodes every document in text.
+	Parse(text string) ([]interface{}, error)
+
+	// Marshal encodes v as a document.
+	Marshal(v interface{}) ([]byte, error)
+}
+
+// EqualsDocument fails the test if the subject, x, is not a document in the
+// format f semantically equal to y.  Mappings are compared regardless of the
+// order of their keys, numbers are compared by value so that 1 and 1.0 are
+// equal, and timestamps are compared by the instant they represent.  The
+// subject may be a string, []byte or io.Reader, and y may be a document in any
+// of those forms, or any other value, which is marshalled with f.  Streams of
+// several documents are compared document by document, and each path at which
+// they differ begins with the index of the document.
+func (a *Assertions) EqualsDocument(f Format, y interface{}) {
+	a.t.Helper()
+
+	if m, failed := a.evaluate(equalsDocument(f, y)); failed {
+		formattedFailure(a, m.format, m.args...)
+	}
+}
+
+func equalsDocument(f Format, y interface{}) predicate {
+	return func(x interface{}) outcome {
+		text, ok, err := baseDocumentText(x)
+		if !ok {
+			return invalid("Expected a string, []byte or io.Reader, but was not\nx: %s", typeNameFor(x))
+		}
+
+		if err != nil {
+			return invalid("Expected subject to be readable, but it was not: %s\nx: %s", err, typeNameFor(x))
+		}
+
+		xs, err := f.Parse(text)
+		if err != nil {
+			return invalid("Expected subject to be valid %s, but it was not: %s", f.Name(), err)
+		}
+
+		ys, err := documentsFor(f, y)
+		if err != nil {
+			return invalid("Expected comparator to be valid %s, but it was not: %s\ny: %s", f.Name(), err, typeNameFor(y))
+		}
+
+		var diffs []string
+		if len(xs) == 1 && len(ys) == 1 {
+			diffs = deepDiff(normalizeDocument(xs[0]), normalizeDocument(ys[0]))
+		} else {
+			diffs = deepDiff(normalizeDocument(xs), normalizeDocument(ys))
+		}
+
+		if len(diffs) == 0 {
+			return passed("Expected %s documents to not be equal, but they were", f.Name())
+		}
+
+		return failed("Expected %s documents to be equal, but they differ\n\n%s", f.Name(), strings.Join(diffs, "\n"))
+	}
+}
+
+// documentsFor parses y if it is text, and otherwise marshals it first.
+func documentsFor(f Format, y interface{}) ([]interface{}, error) {
+	text, ok, err := baseDocumentText(y)
+	if !ok {
+		b, err := f.Marshal(y)
+		if err != nil {
+			return nil, err
+		}
+
+		return f.Parse(string(b))
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return f.Parse(text)
+}
+
+// documentTime is a timestamp in a decoded document, normalized to an RFC 3339
+// string in UTC so that differing timestamps are reported as such rather than
+// by the internal fields of time.Time.
+type documentTime string
+
+// maxExactInteger bounds the magnitude of the integers that can all be
+// represented exactly as a float64.
+const maxExactInteger = 1 << 53
+
+// normalizeDocument returns a copy of the decoded document x in which values
+// that are semantically equal are also deeply equal.  Integers are converted
+// to float64 where that is exact, so that they compare equal to floats of the
+// same value, and timestamps are converted to a documentTime in UTC, so that
+// they compare equal to timestamps for the same instant with a different
+// offset.
+func normalizeDocument(x interface{}) interface{} {
+	switch v := x.(type) {
+	case time.Time:
+		return documentTime(v.UTC().Format(time.RFC3339Nano))
+
+	case []interface{}:
+		elements := make([]interface{}, len(v))
+		for i, element := range v {
+			elements[i] = normalizeDocument(element)
+		}
+
+		return elements
+
+	case map[string]interface{}:
+		entries := make(map[string]interface{}, len(v))
+		for key, value := range v {
+			entries[key] = normalizeDocument(value)
+		}
+
+		return entries
+
+	case map[interface{}]interface{}:
+		entries := make(map[interface{}]interface{}, len(v))
+		for key, value := range v {
+			entries[normalizeDocument(key)] = normalizeDocument(value)
+		}
+
+		return entries
+	}
+
+	xv := reflect.ValueOf(x)
+
+	switch xv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if n := xv.Int(); n > -maxExactInteger && n < maxExactInteger {
+			return float64(n)
+		}
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if n := xv.Uint(); n < maxExactInteger {
+			return float64(n)
+		}
+
+	case reflect.Float32:
+		return xv.Float()
+	}
+
+	return x
+}
@@ -0,0 +1,75 @@
+package test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+// documentFormat is a Format whose documents are given by a map from their
+// text to their decoded value.
+type documentFormat map[string]interface{}
+
+func (documentFormat) Name() string {
+	return "stub"
+}
+
+func (f documentFormat) Parse(text string) ([]interface{}, error) {
+	doc, ok := f[text]
+	if !ok {
+		return nil, fmt.Errorf("unknown document %q", text)
+	}
+
+	return []interface{}{doc}, nil
+}
+
+func (documentFormat) Marshal(v interface{}) ([]byte, error) {
+	return nil, fmt.Errorf("cannot marshal %T", v)
+}
+
+func TestEqualsDocument(t *testing.T) {
+	plus2 := time.FixedZone("+02:00", 2*60*60)
+
+	f := documentFormat{
+		"int":     map[string]interface{}{"n": 1},
+		"float":   map[string]interface{}{"n": 1.0},
+		"other":   map[string]interface{}{"n": 2.5},
+		"large":   map[string]interface{}{"n": int64(1<<53 + 1)},
+		"largef":  map[string]interface{}{"n": float64(1<<53 + 1)},
+		"local":   []interface{}{time.Date(2024, 1, 1, 10, 0, 0, 0, plus2)},
+		"utc":     []interface{}{time.Date(2024, 1, 1, 8, 0, 0, 0, time.UTC)},
+		"later":   []interface{}{time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)},
+		"nested":  map[interface{}]interface{}{uint8(1): []interface{}{float32(0.5)}},
+		"nestedf": map[interface{}]interface{}{1.0: []interface{}{0.5}},
+	}
+
+	testCases := []struct {
+		x       interface{}
+		y       interface{}
+		pass    bool
+		message string
+	}{
+		{x: "int", y: "float", pass: true},
+		{x: "local", y: "utc", pass: true},
+		{x: "nested", y: "nestedf", pass: true},
+		{x: "int", y: "other", pass: false, message: "Expected stub documents to be equal, but they differ\n\n[\"n\"]: 1 != 2.5"},
+		{x: "large", y: "largef", pass: false, message: "[\"n\"]: type int64 != type float64"},
+		{x: "local", y: "later", pass: false, message: "[0]: \"2024-01-01T08:00:00Z\" != \"2024-01-01T10:00:00Z\""},
+		{x: "missing", y: "int", pass: false, message: "Expected subject to be valid stub, but it was not: unknown document \"missing\""},
+		{x: "int", y: 5, pass: false, message: "Expected comparator to be valid stub, but it was not: cannot marshal int\ny: int"},
+	}
+
+	for _, testCase := range testCases {
+		recorder := NewRecorder()
+		That(recorder, testCase.x).EqualsDocument(f, testCase.y)
+
+		if !testCase.pass {
+			assertFailed(t, recorder)
+			assertHelperCount(t, recorder, 3)
+			assertFailureMessage(t, recorder, testCase.message)
+		} else {
+			assertPassed(t, recorder)
+			assertHelperCount(t, recorder, 2)
+		}
+	}
+}
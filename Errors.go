@@ -0,0 +1,177 @@
+package test
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// IsError fails the test if the subject, x, is not a non-nil error.
+func (a *Assertions) IsError() {
+	a.t.Helper()
+
+	if m, failed := a.evaluate(isError()); failed {
+		formattedFailure(a, m.format, m.args...)
+	}
+}
+
+// IsNoError fails the test if the subject, x, is a non-nil error.
+func (a *Assertions) IsNoError() {
+	a.t.Helper()
+
+	if m, failed := a.Not().evaluate(isError()); failed {
+		formattedFailure(a, m.format, m.args...)
+	}
+}
+
+// WrapsError fails the test if the subject, x, is not an error that matches
+// target according to errors.Is.
+func (a *Assertions) WrapsError(target error) {
+	a.t.Helper()
+
+	if m, failed := a.evaluate(wrapsError(target)); failed {
+		formattedFailure(a, m.format, m.args...)
+	}
+}
+
+// HasErrorAs fails the test if no error in the chain of the subject, x, can be
+// assigned to target according to errors.As.  target must be a non-nil pointer
+// to an interface or to a type implementing error.  On success, target is set
+// to the matching error.
+func (a *Assertions) HasErrorAs(target interface{}) {
+	a.t.Helper()
+
+	if m, failed := a.evaluate(errorAs(target)); failed {
+		formattedFailure(a, m.format, m.args...)
+	}
+}
+
+// HasErrorMessage fails the test if the subject, x, is not an error whose
+// message is exactly s.
+func (a *Assertions) HasErrorMessage(s string) {
+	a.t.Helper()
+
+	if m, failed := a.evaluate(errorMessage(s)); failed {
+		formattedFailure(a, m.format, m.args...)
+	}
+}
+
+// HasErrorMessageContaining fails the test if the subject, x, is not an error
+// whose message contains s.
+func (a *Assertions) HasErrorMessageContaining(s string) {
+	a.t.Helper()
+
+	if m, failed := a.evaluate(errorMessageContaining(s)); failed {
+		formattedFailure(a, m.format, m.args...)
+	}
+}
+
+func isError() predicate {
+	return func(x interface{}) outcome {
+		err, ok := baseErrorValue(x)
+		if !ok {
+			return outcome{
+				invalid:  true,
+				failure:  messagef("Expected an error, but was not an error\nx: %s", typeNameFor(x)),
+				negation: messagef("Expected no error, but was not an error\nx: %s", typeNameFor(x)),
+			}
+		}
+
+		if err == nil {
+			return failed("Expected an error, but was <nil>")
+		}
+
+		return passed("Expected no error, but was\n\n%s", errorChainFor(err))
+	}
+}
+
+func wrapsError(target error) predicate {
+	return withError(func(err error) outcome {
+		if !errors.Is(err, target) {
+			return failed("Expected error chain to contain %v, but it did not\n\n%s", target, errorChainFor(err))
+		}
+
+		return passed("Expected error chain to not contain %v, but it did\n\n%s", target, errorChainFor(err))
+	})
+}
+
+func errorAs(target interface{}) predicate {
+	return withError(func(err error) outcome {
+		tt := reflect.TypeOf(target)
+		if tt == nil || tt.Kind() != reflect.Ptr || reflect.ValueOf(target).IsNil() {
+			return invalid("Expected target to be a non-nil pointer\ntarget: %s", typeNameFor(target))
+		}
+
+		errorType := reflect.TypeOf((*error)(nil)).Elem()
+		if tt.Elem().Kind() != reflect.Interface && !tt.Elem().Implements(errorType) {
+			return invalid("Expected target to point to an interface or a type implementing error\ntarget: %s", typeNameFor(target))
+		}
+
+		if !errors.As(err, target) {
+			return failed("Expected error chain to contain an error assignable to %s, but it did not\n\n%s", tt.Elem(), errorChainFor(err))
+		}
+
+		return passed("Expected error chain to not contain an error assignable to %s, but it did\n\n%s", tt.Elem(), errorChainFor(err))
+	})
+}
+
+func errorMessage(s string) predicate {
+	return withError(func(err error) outcome {
+		if err.Error() != s {
+			return failed("Expected error message to be %q, but was %q\n\n%s", s, err.Error(), errorChainFor(err))
+		}
+
+		return passed("Expected error message to not be %q, but was\n\n%s", s, errorChainFor(err))
+	})
+}
+
+func errorMessageContaining(s string) predicate {
+	return withError(func(err error) outcome {
+		if !strings.Contains(err.Error(), s) {
+			return failed("Expected error message to contain %q, but was %q\n\n%s", s, err.Error(), errorChainFor(err))
+		}
+
+		return passed("Expected error message to not contain %q, but was %q\n\n%s", s, err.Error(), errorChainFor(err))
+	})
+}
+
+// withError returns a predicate that applies p to the subject as an error.
+// The outcome is invalid if the subject is not a non-nil error.
+func withError(p func(err error) outcome) predicate {
+	return func(x interface{}) outcome {
+		err, ok := baseErrorValue(x)
+		if !ok {
+			return invalid("Expected an error, but was not an error\nx: %s", typeNameFor(x))
+		}
+
+		if err == nil {
+			return invalid("Expected an error, but was <nil>")
+		}
+
+		return p(err)
+	}
+}
+
+func baseErrorValue(x interface{}) (error, bool) {
+	if x == nil {
+		return nil, true
+	}
+
+	err, ok := x.(error)
+	return err, ok
+}
+
+// errorChainFor renders every error in the unwrap chain of err, outermost
+// first, along with its concrete type.
+func errorChainFor(err error) string {
+	sb := &strings.Builder{}
+	sb.WriteString("error chain:")
+
+	for i := 0; err != nil; i++ {
+		fmt.Fprintf(sb, "\n  [%v] %v: %v", i, typeNameFor(err), err)
+		err = errors.Unwrap(err)
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,177 @@
+package test
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"testing"
+)
+
+func TestIsError(t *testing.T) {
+	testCases := []struct {
+		x       interface{}
+		pass    bool
+		message string
+	}{
+		{x: io.EOF, pass: true},
+		{x: fmt.Errorf("wrapped: %w", io.EOF), pass: true},
+		{x: nil, pass: false, message: "Expected an error, but was <nil>"},
+		{x: error(nil), pass: false, message: "Expected an error, but was <nil>"},
+		{x: "Hello", pass: false, message: "Expected an error, but was not an error\nx: string"},
+	}
+
+	for _, testCase := range testCases {
+		recorder := NewRecorder()
+		That(recorder, testCase.x).IsError()
+
+		if !testCase.pass {
+			assertFailed(t, recorder)
+			assertFailureMessage(t, recorder, testCase.message)
+			assertHelperCount(t, recorder, 3)
+		} else {
+			assertPassed(t, recorder)
+			assertHelperCount(t, recorder, 2)
+		}
+	}
+}
+
+func TestIsNoError(t *testing.T) {
+	testCases := []struct {
+		x       interface{}
+		pass    bool
+		message string
+	}{
+		{x: nil, pass: true},
+		{x: error(nil), pass: true},
+		{x: io.EOF, pass: false, message: "Expected no error, but was\n\nerror chain:\n  [0] *errors.errorString: EOF"},
+		{x: 5, pass: false, message: "Expected no error, but was not an error\nx: int"},
+	}
+
+	for _, testCase := range testCases {
+		recorder := NewRecorder()
+		That(recorder, testCase.x).IsNoError()
+
+		if !testCase.pass {
+			assertFailed(t, recorder)
+			assertFailureMessage(t, recorder, testCase.message)
+			assertHelperCount(t, recorder, 3)
+		} else {
+			assertPassed(t, recorder)
+			assertHelperCount(t, recorder, 2)
+		}
+	}
+}
+
+func TestWrapsError(t *testing.T) {
+	testCases := []struct {
+		x      interface{}
+		target error
+		pass   bool
+	}{
+		{x: io.EOF, target: io.EOF, pass: true},
+		{x: fmt.Errorf("outer: %w", fmt.Errorf("inner: %w", io.EOF)), target: io.EOF, pass: true},
+		{x: fmt.Errorf("outer: %w", io.ErrUnexpectedEOF), target: io.EOF, pass: false},
+		{x: nil, target: io.EOF, pass: false},
+	}
+
+	for _, testCase := range testCases {
+		recorder := NewRecorder()
+		That(recorder, testCase.x).WrapsError(testCase.target)
+
+		if !testCase.pass {
+			assertFailed(t, recorder)
+		} else {
+			assertPassed(t, recorder)
+		}
+	}
+}
+
+func TestWrapsErrorPrintsChain(t *testing.T) {
+	// Arrange.
+	err := fmt.Errorf("outer: %w", io.ErrUnexpectedEOF)
+
+	// Act.
+	recorder := NewRecorder()
+
+	That(recorder, err).WrapsError(io.EOF)
+
+	// Assert.
+	assertFailed(t, recorder)
+	assertHelperCount(t, recorder, 3)
+	assertFailureMessage(t, recorder, "Expected error chain to contain *errors.errorString(\"EOF\"), but it did not\n\nerror chain:\n  [0] *fmt.wrapError: outer: unexpected EOF\n  [1] *errors.errorString: unexpected EOF")
+}
+
+func TestHasErrorAs(t *testing.T) {
+	// Arrange.
+	err := fmt.Errorf("open failed: %w", &os.PathError{Op: "open", Path: "/tmp", Err: os.ErrNotExist})
+
+	// Act.
+	var pathErr *os.PathError
+	recorder := NewRecorder()
+
+	That(recorder, err).HasErrorAs(&pathErr)
+
+	// Assert.
+	assertPassed(t, recorder)
+
+	if pathErr == nil || pathErr.Path != "/tmp" {
+		t.Fatalf("Expected target to be set to the matching error")
+	}
+}
+
+func TestHasErrorAsFailures(t *testing.T) {
+	var linkErr *os.LinkError
+
+	testCases := []struct {
+		target  interface{}
+		message string
+	}{
+		{target: &linkErr, message: "Expected error chain to contain an error assignable to *os.LinkError, but it did not"},
+		{target: nil, message: "Expected target to be a non-nil pointer\ntarget: nil"},
+		{target: linkErr, message: "Expected target to be a non-nil pointer\ntarget: *os.LinkError"},
+		{target: new(int), message: "Expected target to point to an interface or a type implementing error\ntarget: *int"},
+	}
+
+	for _, testCase := range testCases {
+		recorder := NewRecorder()
+		That(recorder, io.EOF).HasErrorAs(testCase.target)
+
+		assertFailed(t, recorder)
+		assertFailureMessage(t, recorder, testCase.message)
+	}
+}
+
+func TestHasErrorMessage(t *testing.T) {
+	testCases := []struct {
+		x       interface{}
+		s       string
+		exact   bool
+		contain bool
+	}{
+		{x: errors.New("not found"), s: "not found", exact: true, contain: true},
+		{x: errors.New("user not found"), s: "not found", exact: false, contain: true},
+		{x: errors.New("forbidden"), s: "not found", exact: false, contain: false},
+		{x: nil, s: "", exact: false, contain: false},
+	}
+
+	for _, testCase := range testCases {
+		exact := NewRecorder()
+		contain := NewRecorder()
+
+		That(exact, testCase.x).HasErrorMessage(testCase.s)
+		That(contain, testCase.x).HasErrorMessageContaining(testCase.s)
+
+		if testCase.exact {
+			assertPassed(t, exact)
+		} else {
+			assertFailed(t, exact)
+		}
+
+		if testCase.contain {
+			assertPassed(t, contain)
+		} else {
+			assertFailed(t, contain)
+		}
+	}
+}
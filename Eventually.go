@@ -0,0 +1,238 @@
+package test
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// DefaultPollInterval is the interval between attempts used by
+// That(t, fn).Eventually when no interval has been set with Every.
+const DefaultPollInterval = 10 * time.Millisecond
+
+// Eventually fails the test if condition does not return true within timeout.
+// The condition is called every interval, which must be positive, until it
+// returns true.
+func Eventually(t T, condition func() bool, timeout time.Duration, interval time.Duration) {
+	a := assertionsFor(t, condition)
+	a.t.Helper()
+
+	if interval <= 0 {
+		formattedFailure(a, "Expected a positive poll interval, but was %s", interval)
+		return
+	}
+
+	deadline := time.Now().Add(timeout)
+	attempts := 0
+
+	for {
+		attempts++
+		if condition() {
+			return
+		}
+
+		if !time.Now().Before(deadline) {
+			formattedFailure(a, "Expected condition to become true within %s, but it was still false after %v attempts", timeout, attempts)
+			return
+		}
+
+		time.Sleep(interval)
+	}
+}
+
+// Consistently fails the test if condition returns false at any point during
+// duration.  The condition is called every interval, which must be positive,
+// until duration elapses.
+func Consistently(t T, condition func() bool, duration time.Duration, interval time.Duration) {
+	a := assertionsFor(t, condition)
+	a.t.Helper()
+
+	if interval <= 0 {
+		formattedFailure(a, "Expected a positive poll interval, but was %s", interval)
+		return
+	}
+
+	deadline := time.Now().Add(duration)
+	attempts := 0
+
+	for {
+		attempts++
+		if !condition() {
+			formattedFailure(a, "Expected condition to remain true for %s, but it was false on attempt %v", duration, attempts)
+			return
+		}
+
+		if !time.Now().Before(deadline) {
+			return
+		}
+
+		time.Sleep(interval)
+	}
+}
+
+// EventualAssertions defines assertions that are retried against a freshly
+// evaluated subject until they pass or a timeout elapses.
+type EventualAssertions struct {
+	a        *Assertions
+	fn       func() interface{}
+	within   time.Duration
+	interval time.Duration
+}
+
+// Eventually returns a new *EventualAssertions that repeatedly evaluates the
+// subject, x, which must be a function taking no arguments and returning one
+// value, until the chained assertion passes or within elapses.
+func (a *Assertions) Eventually(within time.Duration) *EventualAssertions {
+	a.t.Helper()
+
+	e := &EventualAssertions{
+		a:        a,
+		within:   within,
+		interval: DefaultPollInterval,
+	}
+
+	v := reflect.ValueOf(a.x)
+	if v.Kind() != reflect.Func || v.IsNil() || v.Type().NumIn() != 0 || v.Type().NumOut() != 1 {
+		formattedFailure(a, "Expected subject to be a non-nil function with no arguments and one result, but was not\nx: %s", typeNameFor(a.x))
+		return e
+	}
+
+	e.fn = func() interface{} {
+		return v.Call(nil)[0].Interface()
+	}
+
+	return e
+}
+
+// Every sets the interval between attempts, which must be positive, and
+// returns e.
+func (e *EventualAssertions) Every(interval time.Duration) *EventualAssertions {
+	e.interval = interval
+	return e
+}
+
+// Passes fails the test if assert does not pass against the evaluated subject
+// within the timeout.  The provided *Assertions must be used to make the
+// assertion, and is negated if Not was called before Eventually.
+func (e *EventualAssertions) Passes(assert func(a *Assertions)) {
+	e.a.t.Helper()
+
+	if e.fn == nil {
+		return
+	}
+
+	if e.interval <= 0 {
+		formattedFailure(e.a, "Expected a positive poll interval, but was %s", e.interval)
+		return
+	}
+
+	deadline := time.Now().Add(e.within)
+	attempts := 0
+
+	for {
+		attempts++
+		value := e.fn()
+
+		p := &probe{}
+		a := That(p, value)
+		if e.a.negated {
+			a = a.Not()
+		}
+
+		assert(a)
+		if !p.failed {
+			return
+		}
+
+		if !time.Now().Before(deadline) {
+			formattedFailure(e.a, "Expected assertion to pass within %s, but it still failed after %v attempts\nlast value: %v\n\n%s", e.within, attempts, value, p.message)
+			return
+		}
+
+		time.Sleep(e.interval)
+	}
+}
+
+// IsEqualTo fails the test if the subject does not become equal to y.
+func (e *EventualAssertions) IsEqualTo(y interface{}) {
+	e.a.t.Helper()
+	e.Passes(func(a *Assertions) { a.IsEqualTo(y) })
+}
+
+// IsNotEqualTo fails the test if the subject does not become not equal to y.
+func (e *EventualAssertions) IsNotEqualTo(y interface{}) {
+	e.a.t.Helper()
+	e.Passes(func(a *Assertions) { a.IsNotEqualTo(y) })
+}
+
+// IsDeeplyEqualTo fails the test if the subject does not become deeply equal
+// to y.
+func (e *EventualAssertions) IsDeeplyEqualTo(y interface{}) {
+	e.a.t.Helper()
+	e.Passes(func(a *Assertions) { a.IsDeeplyEqualTo(y) })
+}
+
+// IsNil fails the test if the subject does not become nil.
+func (e *EventualAssertions) IsNil() {
+	e.a.t.Helper()
+	e.Passes(func(a *Assertions) { a.IsNil() })
+}
+
+// IsNotNil fails the test if the subject does not become not nil.
+func (e *EventualAssertions) IsNotNil() {
+	e.a.t.Helper()
+	e.Passes(func(a *Assertions) { a.IsNotNil() })
+}
+
+// IsTrue fails the test if the subject does not become true.
+func (e *EventualAssertions) IsTrue() {
+	e.a.t.Helper()
+	e.Passes(func(a *Assertions) { a.IsTrue() })
+}
+
+// IsFalse fails the test if the subject does not become false.
+func (e *EventualAssertions) IsFalse() {
+	e.a.t.Helper()
+	e.Passes(func(a *Assertions) { a.IsFalse() })
+}
+
+// IsGreaterThan fails the test if the subject does not become greater than y.
+func (e *EventualAssertions) IsGreaterThan(y interface{}) {
+	e.a.t.Helper()
+	e.Passes(func(a *Assertions) { a.IsGreaterThan(y) })
+}
+
+// IsLessThan fails the test if the subject does not become less than y.
+func (e *EventualAssertions) IsLessThan(y interface{}) {
+	e.a.t.Helper()
+	e.Passes(func(a *Assertions) { a.IsLessThan(y) })
+}
+
+// probe is an implementation of T that silently records the first failure,
+// allowing an assertion to be attempted without failing the test.
+type probe struct {
+	failed  bool
+	message string
+}
+
+var _ T = &probe{}
+
+func (*probe) Name() string {
+	return ""
+}
+
+func (*probe) Helper() {}
+
+func (p *probe) Errorf(format string, args ...interface{}) {
+	p.Fatalf(format, args...)
+}
+
+func (p *probe) Fatalf(format string, args ...interface{}) {
+	if p.failed {
+		return
+	}
+
+	p.failed = true
+	p.message = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(fmt.Sprintf(format, args...)), "×"))
+}
@@ -0,0 +1,184 @@
+package test
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestEventually(t *testing.T) {
+	// Arrange.
+	var calls int32
+	condition := func() bool {
+		return atomic.AddInt32(&calls, 1) >= 3
+	}
+
+	// Act.
+	recorder := NewRecorder()
+
+	Eventually(recorder, condition, time.Second, time.Millisecond)
+
+	// Assert.
+	assertPassed(t, recorder)
+	assertHelperCount(t, recorder, 1)
+
+	if calls != 3 {
+		t.Fatalf("Expected the condition to be called 3 times but was called %v times", calls)
+	}
+}
+
+func TestEventuallyTimesOut(t *testing.T) {
+	// Arrange.
+	recorder := NewRecorder()
+
+	// Act.
+	Eventually(recorder, func() bool { return false }, 5*time.Millisecond, time.Millisecond)
+
+	// Assert.
+	assertFailed(t, recorder)
+	assertHelperCount(t, recorder, 2)
+	assertFailureMessage(t, recorder, "Expected condition to become true within 5ms, but it was still false after")
+}
+
+func TestConsistently(t *testing.T) {
+	// Arrange.
+	var calls int32
+	recorder := NewRecorder()
+
+	// Act.
+	Consistently(recorder, func() bool { return atomic.AddInt32(&calls, 1) < 4 }, time.Second, time.Millisecond)
+
+	// Assert.
+	assertFailed(t, recorder)
+	assertFailureMessage(t, recorder, "Expected condition to remain true for 1s, but it was false on attempt 4")
+
+	recorder = NewRecorder()
+	Consistently(recorder, func() bool { return true }, 5*time.Millisecond, time.Millisecond)
+	assertPassed(t, recorder)
+}
+
+func TestChainedEventually(t *testing.T) {
+	// Arrange.
+	var counter int32
+	fn := func() interface{} {
+		return atomic.AddInt32(&counter, 1)
+	}
+
+	// Act.
+	recorder := NewRecorder()
+
+	That(recorder, fn).Eventually(time.Second).Every(time.Millisecond).IsEqualTo(int32(5))
+
+	// Assert.
+	assertPassed(t, recorder)
+
+	if counter != 5 {
+		t.Fatalf("Expected the subject to be evaluated 5 times but was evaluated %v times", counter)
+	}
+}
+
+func TestChainedEventuallyAcceptsTypedFunctions(t *testing.T) {
+	// Arrange.
+	values := []string{"pending", "pending", "done"}
+	i := 0
+	fn := func() string {
+		v := values[i]
+		if i < len(values)-1 {
+			i++
+		}
+		return v
+	}
+
+	// Act.
+	recorder := NewRecorder()
+
+	That(recorder, fn).Eventually(time.Second).Every(time.Millisecond).IsEqualTo("done")
+
+	// Assert.
+	assertPassed(t, recorder)
+}
+
+func TestChainedEventuallyTimesOut(t *testing.T) {
+	// Arrange.
+	fn := func() interface{} { return 4 }
+
+	// Act.
+	recorder := NewRecorder()
+
+	That(recorder, fn).Eventually(5 * time.Millisecond).Every(time.Millisecond).IsEqualTo(5)
+
+	// Assert.
+	assertFailed(t, recorder)
+	assertFailureMessage(t, recorder, "Expected assertion to pass within 5ms, but it still failed after")
+	assertFailureMessage(t, recorder, "last value: 4\n\nExpected 4 to be equal to 5")
+}
+
+func TestChainedEventuallyExpectsFunction(t *testing.T) {
+	// Arrange.
+	recorder := NewRecorder()
+
+	// Act.
+	That(recorder, 5).Eventually(time.Second).IsEqualTo(5)
+
+	// Assert.
+	assertFailed(t, recorder)
+	assertFailureMessage(t, recorder, "Expected subject to be a non-nil function with no arguments and one result, but was not\nx: int")
+}
+
+func TestChainedEventuallyNot(t *testing.T) {
+	// Arrange.
+	var counter int32
+	fn := func() interface{} {
+		return atomic.AddInt32(&counter, 1)
+	}
+
+	// Act.
+	recorder := NewRecorder()
+
+	That(recorder, fn).Not().Eventually(time.Second).Every(time.Millisecond).IsLessThan(3)
+
+	// Assert.
+	assertPassed(t, recorder)
+
+	if counter != 3 {
+		t.Fatalf("Expected the subject to be evaluated 3 times but was evaluated %v times", counter)
+	}
+}
+
+func TestEventuallyExpectsPositiveInterval(t *testing.T) {
+	testCases := []struct {
+		interval time.Duration
+		message  string
+	}{
+		{interval: 0, message: "Expected a positive poll interval, but was 0s"},
+		{interval: -time.Millisecond, message: "Expected a positive poll interval, but was -1ms"},
+	}
+
+	for _, testCase := range testCases {
+		var calls int32
+		condition := func() bool {
+			atomic.AddInt32(&calls, 1)
+			return false
+		}
+
+		eventually := NewRecorder()
+		consistently := NewRecorder()
+		chained := NewRecorder()
+
+		Eventually(eventually, condition, time.Second, testCase.interval)
+		Consistently(consistently, condition, time.Second, testCase.interval)
+		That(chained, func() interface{} { return 4 }).Eventually(time.Second).Every(testCase.interval).IsEqualTo(4)
+
+		assertFailed(t, eventually)
+		assertHelperCount(t, eventually, 2)
+		assertFailureMessage(t, eventually, testCase.message)
+		assertFailed(t, consistently)
+		assertFailureMessage(t, consistently, testCase.message)
+		assertFailed(t, chained)
+		assertFailureMessage(t, chained, testCase.message)
+
+		if calls != 0 {
+			t.Fatalf("Expected the condition to not be called but was called %v times", calls)
+		}
+	}
+}
@@ -0,0 +1,376 @@
+package test
+
+import (
+	"math"
+	"reflect"
+	"strconv"
+)
+
+// IsCloseTo fails the test if the subject, x, is not a number within delta of
+// y.  NaN is never close to anything.
+func (a *Assertions) IsCloseTo(y float64, delta float64) {
+	a.t.Helper()
+
+	if m, failed := a.evaluate(closeTo(y, delta)); failed {
+		formattedFailure(a, m.format, m.args...)
+	}
+}
+
+// IsWithinRelative fails the test if the subject, x, is not a number whose
+// difference from y is at most epsilon times the larger of their magnitudes.
+func (a *Assertions) IsWithinRelative(y float64, epsilon float64) {
+	a.t.Helper()
+
+	if m, failed := a.evaluate(withinRelative(y, epsilon)); failed {
+		formattedFailure(a, m.format, m.args...)
+	}
+}
+
+// IsWithinPercent fails the test if the subject, x, is not a number within
+// percent percent of y, relative to the larger of their magnitudes.
+func (a *Assertions) IsWithinPercent(y float64, percent float64) {
+	a.t.Helper()
+
+	if m, failed := a.evaluate(withinPercent(y, percent)); failed {
+		formattedFailure(a, m.format, m.args...)
+	}
+}
+
+// IsWithinULPs fails the test if the subject, x, is not a number within n
+// units in the last place of y.  A float32 subject is compared at float32
+// precision.
+func (a *Assertions) IsWithinULPs(y float64, n uint64) {
+	a.t.Helper()
+
+	if m, failed := a.evaluate(withinULPs(y, n)); failed {
+		formattedFailure(a, m.format, m.args...)
+	}
+}
+
+// IsNaN fails the test if the subject, x, is not a floating point NaN.
+func (a *Assertions) IsNaN() {
+	a.t.Helper()
+
+	if m, failed := a.evaluate(isNaN()); failed {
+		formattedFailure(a, m.format, m.args...)
+	}
+}
+
+// IsInf fails the test if the subject, x, is not an infinity with the given
+// sign.  As with math.IsInf, a positive sign requires +Inf, a negative sign
+// requires -Inf, and zero accepts either.
+func (a *Assertions) IsInf(sign int) {
+	a.t.Helper()
+
+	if m, failed := a.evaluate(isInf(sign)); failed {
+		formattedFailure(a, m.format, m.args...)
+	}
+}
+
+// IsFinite fails the test if the subject, x, is NaN or an infinity.
+func (a *Assertions) IsFinite() {
+	a.t.Helper()
+
+	if m, failed := a.evaluate(isFinite()); failed {
+		formattedFailure(a, m.format, m.args...)
+	}
+}
+
+// HasElementsCloseTo fails the test if the subject, x, and ys are not numeric
+// slices or arrays of the same length whose corresponding elements are within
+// delta of each other.
+func (a *Assertions) HasElementsCloseTo(ys interface{}, delta float64) {
+	a.t.Helper()
+
+	if m, failed := a.evaluate(elementsCloseTo(ys, delta)); failed {
+		formattedFailure(a, m.format, m.args...)
+	}
+}
+
+// HasElementsWithinRelative fails the test if the subject, x, and ys are not
+// numeric slices or arrays of the same length whose corresponding elements are
+// within a relative error of epsilon of each other.
+func (a *Assertions) HasElementsWithinRelative(ys interface{}, epsilon float64) {
+	a.t.Helper()
+
+	if m, failed := a.evaluate(elementsWithinRelative(ys, epsilon)); failed {
+		formattedFailure(a, m.format, m.args...)
+	}
+}
+
+// HasElementsWithinULPs fails the test if the subject, x, and ys are not
+// numeric slices or arrays of the same length whose corresponding elements are
+// within n units in the last place of each other.
+func (a *Assertions) HasElementsWithinULPs(ys interface{}, n uint64) {
+	a.t.Helper()
+
+	if m, failed := a.evaluate(elementsWithinULPs(ys, n)); failed {
+		formattedFailure(a, m.format, m.args...)
+	}
+}
+
+func closeTo(y float64, delta float64) predicate {
+	return withFloat(func(xf float64) outcome {
+		if !baseCloseToTest(xf, y, delta) {
+			return failed("Expected %v to be within %v of %v, but the difference was %v", xf, delta, y, math.Abs(xf-y))
+		}
+
+		return passed("Expected %v to not be within %v of %v, but the difference was %v", xf, delta, y, math.Abs(xf-y))
+	})
+}
+
+func withinRelative(y float64, epsilon float64) predicate {
+	return withFloat(func(xf float64) outcome {
+		if !baseWithinRelativeTest(xf, y, epsilon) {
+			return failed("Expected %v to be within a relative error of %v of %v, but the relative error was %v", xf, epsilon, y, relativeError(xf, y))
+		}
+
+		return passed("Expected %v to not be within a relative error of %v of %v, but the relative error was %v", xf, epsilon, y, relativeError(xf, y))
+	})
+}
+
+func withinPercent(y float64, percent float64) predicate {
+	return withFloat(func(xf float64) outcome {
+		if !baseWithinRelativeTest(xf, y, percent/100) {
+			return failed("Expected %v to be within %v%% of %v, but it differed by %v%%", xf, percent, y, relativeError(xf, y)*100)
+		}
+
+		return passed("Expected %v to not be within %v%% of %v, but it differed by %v%%", xf, percent, y, relativeError(xf, y)*100)
+	})
+}
+
+func withinULPs(y float64, n uint64) predicate {
+	return func(x interface{}) outcome {
+		_, single := x.(float32)
+
+		return withFloat(func(xf float64) outcome {
+			d, ok := ulpDistance(xf, y, single)
+			if !ok || d > n {
+				return failed("Expected %v to be within %d ULPs of %v, but was %s ULPs away", xf, n, y, describeULPs(d, ok))
+			}
+
+			return passed("Expected %v to not be within %d ULPs of %v, but was %d ULPs away", xf, n, y, d)
+		})(x)
+	}
+}
+
+func isNaN() predicate {
+	return withFloat(func(xf float64) outcome {
+		if !math.IsNaN(xf) {
+			return failed("Expected NaN, but was %v", xf)
+		}
+
+		return passed("Expected a number other than NaN, but was %v", xf)
+	})
+}
+
+func isInf(sign int) predicate {
+	return withFloat(func(xf float64) outcome {
+		if !math.IsInf(xf, sign) {
+			return failed("Expected %s, but was %v", describeInf(sign), xf)
+		}
+
+		return passed("Expected a number other than %s, but was %v", describeInf(sign), xf)
+	})
+}
+
+func isFinite() predicate {
+	return withFloat(func(xf float64) outcome {
+		if math.IsNaN(xf) || math.IsInf(xf, 0) {
+			return failed("Expected a finite number, but was %v", xf)
+		}
+
+		return passed("Expected NaN or an infinity, but was %v", xf)
+	})
+}
+
+func elementsCloseTo(ys interface{}, delta float64) predicate {
+	return elementwise(ys, "within %v of", delta, func(x float64, y float64) (bool, float64) {
+		return baseCloseToTest(x, y, delta), math.Abs(x - y)
+	})
+}
+
+func elementsWithinRelative(ys interface{}, epsilon float64) predicate {
+	return elementwise(ys, "within a relative error of %v of", epsilon, func(x float64, y float64) (bool, float64) {
+		return baseWithinRelativeTest(x, y, epsilon), relativeError(x, y)
+	})
+}
+
+func elementsWithinULPs(ys interface{}, n uint64) predicate {
+	return func(x interface{}) outcome {
+		single := false
+		if xv := reflect.ValueOf(x); isSequence(xv) {
+			single = xv.Type().Elem().Kind() == reflect.Float32
+		}
+
+		return elementwise(ys, "within %v ULPs of", n, func(x float64, y float64) (bool, float64) {
+			d, ok := ulpDistance(x, y, single)
+			if !ok {
+				return false, math.Inf(1)
+			}
+			return d <= n, float64(d)
+		})(x)
+	}
+}
+
+// elementwise returns a predicate that compares the numeric elements of the
+// subject and ys pairwise using cmp, which reports whether a pair is
+// acceptable and how far apart it is.  On failure, the pair furthest apart is
+// reported.
+func elementwise(ys interface{}, relation string, tolerance interface{}, cmp func(x float64, y float64) (bool, float64)) predicate {
+	return func(x interface{}) outcome {
+		xs, ok1 := baseFloatSliceValue(x)
+		yfs, ok2 := baseFloatSliceValue(ys)
+		if !ok1 || !ok2 {
+			return invalid("Expected both subject and comparator to be numeric slices or arrays\nx: %s\ny: %s", typeNameFor(x), typeNameFor(ys))
+		}
+
+		if len(xs) != len(yfs) {
+			return failed("Expected subject to have length %v but had length %v", len(yfs), len(xs))
+		}
+
+		failures := 0
+		worst := -1
+		worstDistance := 0.0
+
+		for i := range xs {
+			ok, distance := cmp(xs[i], yfs[i])
+			if ok {
+				continue
+			}
+
+			failures++
+			if worst < 0 || distance > worstDistance || math.IsNaN(distance) {
+				worst = i
+				worstDistance = distance
+			}
+		}
+
+		if failures > 0 {
+			return failed("Expected %v to be element-wise "+relation+" %v, but %v of %v elements were not\nworst at index %v: %v vs %v (off by %v)", x, tolerance, ys, failures, len(xs), worst, xs[worst], yfs[worst], worstDistance)
+		}
+
+		return passed("Expected %v to not be element-wise "+relation+" %v, but it was", x, tolerance, ys)
+	}
+}
+
+// withFloat returns a predicate that applies p to the subject as a float64.
+// The outcome is invalid if the subject is not a number.
+func withFloat(p func(xf float64) outcome) predicate {
+	return func(x interface{}) outcome {
+		xn, ok := baseNumberValue(x)
+		if !ok {
+			return invalid("Expected a number, but was not\nx: %s", typeNameFor(x))
+		}
+
+		return p(xn.float64())
+	}
+}
+
+func baseFloatSliceValue(x interface{}) ([]float64, bool) {
+	xv := reflect.ValueOf(x)
+	if !isSequence(xv) {
+		return nil, false
+	}
+
+	fs := make([]float64, xv.Len())
+	for i := range fs {
+		n, ok := baseNumberValue(xv.Index(i).Interface())
+		if !ok {
+			return nil, false
+		}
+
+		fs[i] = n.float64()
+	}
+
+	return fs, true
+}
+
+func baseCloseToTest(x float64, y float64, delta float64) bool {
+	if x == y {
+		return true
+	}
+
+	return math.Abs(x-y) <= delta
+}
+
+func baseWithinRelativeTest(x float64, y float64, epsilon float64) bool {
+	if x == y {
+		return true
+	}
+
+	return relativeError(x, y) <= epsilon
+}
+
+// relativeError returns the difference between x and y divided by the larger
+// of their magnitudes.
+func relativeError(x float64, y float64) float64 {
+	if x == y {
+		return 0
+	}
+
+	return math.Abs(x-y) / math.Max(math.Abs(x), math.Abs(y))
+}
+
+// ulpDistance returns the number of representable floating point values
+// between x and y, at float32 precision if single is true.  It returns false
+// if either value is NaN.
+func ulpDistance(x float64, y float64, single bool) (uint64, bool) {
+	if math.IsNaN(x) || math.IsNaN(y) {
+		return 0, false
+	}
+
+	var ox, oy int64
+	if single {
+		ox = orderedFloat32Bits(float32(x))
+		oy = orderedFloat32Bits(float32(y))
+	} else {
+		ox = orderedFloat64Bits(x)
+		oy = orderedFloat64Bits(y)
+	}
+
+	if ox < oy {
+		ox, oy = oy, ox
+	}
+
+	return uint64(ox) - uint64(oy), true
+}
+
+// orderedFloat64Bits maps f onto an integer such that adjacent floating point
+// values map to adjacent integers, and +0 and -0 map to the same integer.
+func orderedFloat64Bits(f float64) int64 {
+	b := int64(math.Float64bits(f))
+	if b < 0 {
+		b = math.MinInt64 - b
+	}
+
+	return b
+}
+
+func orderedFloat32Bits(f float32) int64 {
+	b := int32(math.Float32bits(f))
+	if b < 0 {
+		b = math.MinInt32 - b
+	}
+
+	return int64(b)
+}
+
+func describeULPs(d uint64, ok bool) string {
+	if !ok {
+		return "incomparably many"
+	}
+
+	return strconv.FormatUint(d, 10)
+}
+
+func describeInf(sign int) string {
+	switch {
+	case sign > 0:
+		return "+Inf"
+	case sign < 0:
+		return "-Inf"
+	}
+
+	return "±Inf"
+}
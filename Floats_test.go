@@ -0,0 +1,196 @@
+package test
+
+import (
+	"math"
+	"testing"
+)
+
+func TestIsCloseTo(t *testing.T) {
+	testCases := []struct {
+		x     interface{}
+		y     float64
+		delta float64
+		pass  bool
+	}{
+		{x: 0.1 + 0.2, y: 0.3, delta: 1e-9, pass: true},
+		{x: 1.0, y: 1.1, delta: 0.05, pass: false},
+		{x: float32(2.5), y: 2.5, delta: 0, pass: true},
+		{x: 10, y: 10.4, delta: 0.5, pass: true},
+		{x: math.NaN(), y: math.NaN(), delta: 1, pass: false},
+		{x: math.Inf(1), y: math.Inf(1), delta: 0, pass: true},
+	}
+
+	for _, testCase := range testCases {
+		recorder := NewRecorder()
+		That(recorder, testCase.x).IsCloseTo(testCase.y, testCase.delta)
+
+		if !testCase.pass {
+			assertFailed(t, recorder)
+			assertFailureMessage(t, recorder, "to be within %v of %v, but the difference was", testCase.delta, testCase.y)
+		} else {
+			assertPassed(t, recorder)
+			assertHelperCount(t, recorder, 2)
+		}
+	}
+}
+
+func TestIsCloseToExpectsNumber(t *testing.T) {
+	// Arrange.
+	recorder := NewRecorder()
+
+	// Act.
+	That(recorder, "1.0").IsCloseTo(1, 0)
+
+	// Assert.
+	assertFailed(t, recorder)
+	assertFailureMessage(t, recorder, "Expected a number, but was not\nx: string")
+}
+
+func TestIsWithinRelativeAndPercent(t *testing.T) {
+	testCases := []struct {
+		x       float64
+		y       float64
+		epsilon float64
+		pass    bool
+	}{
+		{x: 100, y: 101, epsilon: 0.01, pass: true},
+		{x: 100, y: 102, epsilon: 0.01, pass: false},
+		{x: 1e-12, y: 1.1e-12, epsilon: 0.1, pass: true},
+		{x: 0, y: 0, epsilon: 0, pass: true},
+		{x: 0, y: 1e-300, epsilon: 0.5, pass: false},
+	}
+
+	for _, testCase := range testCases {
+		relative := NewRecorder()
+		percent := NewRecorder()
+
+		That(relative, testCase.x).IsWithinRelative(testCase.y, testCase.epsilon)
+		That(percent, testCase.x).IsWithinPercent(testCase.y, testCase.epsilon*100)
+
+		if !testCase.pass {
+			assertFailed(t, relative)
+			assertFailed(t, percent)
+			assertFailureMessage(t, percent, "to be within %v%% of %v", testCase.epsilon*100, testCase.y)
+		} else {
+			assertPassed(t, relative)
+			assertPassed(t, percent)
+		}
+	}
+}
+
+func TestIsWithinULPs(t *testing.T) {
+	testCases := []struct {
+		x    interface{}
+		y    float64
+		n    uint64
+		pass bool
+	}{
+		{x: 1.0, y: math.Nextafter(1, 2), n: 1, pass: true},
+		{x: 1.0, y: math.Nextafter(math.Nextafter(1, 2), 2), n: 1, pass: false},
+		{x: 0.0, y: math.Copysign(0, -1), n: 0, pass: true},
+		{x: math.SmallestNonzeroFloat64, y: -math.SmallestNonzeroFloat64, n: 2, pass: true},
+		{x: float32(1), y: float64(math.Nextafter32(1, 2)), n: 1, pass: true},
+		{x: math.NaN(), y: 1, n: 100, pass: false},
+	}
+
+	for _, testCase := range testCases {
+		recorder := NewRecorder()
+		That(recorder, testCase.x).IsWithinULPs(testCase.y, testCase.n)
+
+		if !testCase.pass {
+			assertFailed(t, recorder)
+			assertFailureMessage(t, recorder, "to be within %d ULPs of", testCase.n)
+		} else {
+			assertPassed(t, recorder)
+		}
+	}
+}
+
+func TestSpecialFloatValues(t *testing.T) {
+	testCases := []struct {
+		x      float64
+		nan    bool
+		posInf bool
+		negInf bool
+		finite bool
+	}{
+		{x: 1.5, finite: true},
+		{x: math.NaN(), nan: true},
+		{x: math.Inf(1), posInf: true},
+		{x: math.Inf(-1), negInf: true},
+	}
+
+	for _, testCase := range testCases {
+		nan := NewRecorder()
+		posInf := NewRecorder()
+		negInf := NewRecorder()
+		anyInf := NewRecorder()
+		finite := NewRecorder()
+
+		That(nan, testCase.x).IsNaN()
+		That(posInf, testCase.x).IsInf(1)
+		That(negInf, testCase.x).IsInf(-1)
+		That(anyInf, testCase.x).IsInf(0)
+		That(finite, testCase.x).IsFinite()
+
+		assertOutcome(t, nan, testCase.nan)
+		assertOutcome(t, posInf, testCase.posInf)
+		assertOutcome(t, negInf, testCase.negInf)
+		assertOutcome(t, anyInf, testCase.posInf || testCase.negInf)
+		assertOutcome(t, finite, testCase.finite)
+	}
+}
+
+func TestHasElementsCloseTo(t *testing.T) {
+	testCases := []struct {
+		x       interface{}
+		ys      interface{}
+		pass    bool
+		message string
+	}{
+		{x: []float64{1, 2, 3}, ys: []float64{1.01, 1.99, 3}, pass: true},
+		{x: [3]float32{1, 2, 3}, ys: []int{1, 2, 3}, pass: true},
+		{x: []float64{1, 2, 3}, ys: []float64{1.5, 2, 3.2}, pass: false, message: "but 2 of 3 elements were not\nworst at index 0: 1 vs 1.5 (off by 0.5)"},
+		{x: []float64{1, 2}, ys: []float64{1, 2, 3}, pass: false, message: "Expected subject to have length 3 but had length 2"},
+		{x: []string{"1"}, ys: []float64{1}, pass: false, message: "Expected both subject and comparator to be numeric slices or arrays"},
+	}
+
+	for _, testCase := range testCases {
+		recorder := NewRecorder()
+		That(recorder, testCase.x).HasElementsCloseTo(testCase.ys, 0.05)
+
+		if !testCase.pass {
+			assertFailed(t, recorder)
+			assertFailureMessage(t, recorder, testCase.message)
+		} else {
+			assertPassed(t, recorder)
+		}
+	}
+}
+
+func TestHasElementsWithinRelativeAndULPs(t *testing.T) {
+	// Arrange.
+	x := []float64{100, 1, math.Nextafter(1, 2)}
+
+	// Act.
+	relative := NewRecorder()
+	ulps := NewRecorder()
+
+	That(relative, x).HasElementsWithinRelative([]float64{101, 1, 1}, 0.001)
+	That(ulps, x).HasElementsWithinULPs([]float64{100, 1, 1}, 1)
+
+	// Assert.
+	assertFailed(t, relative)
+	assertFailureMessage(t, relative, "but 1 of 3 elements were not\nworst at index 0: 100 vs 101")
+	assertPassed(t, ulps)
+}
+
+func assertOutcome(t *testing.T, recorder *Recorder, pass bool) {
+	t.Helper()
+
+	if pass {
+		assertPassed(t, recorder)
+	} else {
+		assertFailed(t, recorder)
+	}
+}
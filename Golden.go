@@ -0,0 +1,172 @@
+package test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// goldenDir is the directory, relative to the package being tested, in which
+// golden files are stored.
+var goldenDir = "testdata"
+
+// goldenUpdateEnv is the environment variable that, when set to a true value,
+// causes golden files to be rewritten rather than compared against.
+const goldenUpdateEnv = "UPDATE_GOLDEN"
+
+// MatchesGolden fails the test if the subject, x, does not match the golden
+// file testdata/<TestName>/<name>.golden.  Strings and byte slices are
+// compared as they are, and any other value is serialized as indented JSON.
+// Subtests are stored in nested directories, one for each level of the test
+// name.  When tests are run with the UPDATE_GOLDEN environment variable set to
+// true, or with an -update flag that the test package defines, the golden file
+// is written with the subject instead.
+func (a *Assertions) MatchesGolden(name string) {
+	a.t.Helper()
+
+	if m, failed := a.evaluate(matchesGolden(goldenPathFor(a.t.Name(), name))); failed {
+		formattedFailure(a, m.format, m.args...)
+	}
+}
+
+func matchesGolden(path string) predicate {
+	return func(x interface{}) outcome {
+		actual, err := goldenContentFor(x)
+		if err != nil {
+			return invalid("Expected a subject that can be written to a golden file, but it could not be serialized: %s\nx: %s", err, typeNameFor(x))
+		}
+
+		if updatingGoldenFiles() {
+			if err := writeGoldenFile(path, actual); err != nil {
+				return invalid("Expected golden file %s to be written, but it could not be: %s", path, err)
+			}
+
+			return passed("Expected subject to not match golden file %s, but it was rewritten with the subject", path)
+		}
+
+		golden, err := os.ReadFile(path)
+		if errors.Is(err, fs.ErrNotExist) {
+			return invalid("Expected golden file %s to exist, but it did not\n\nRun the test with %s=true to create it", path, goldenUpdateEnv)
+		} else if err != nil {
+			return invalid("Expected golden file %s to be readable, but it was not: %s", path, err)
+		}
+
+		if !bytes.Equal(actual, golden) {
+			return failed("Expected subject to match golden file %s, but it differs\n\n%s", path, goldenDiffFor(actual, golden))
+		}
+
+		return passed("Expected subject to not match golden file %s, but it did", path)
+	}
+}
+
+// goldenPathFor returns the path of the golden file with the provided name for
+// the test with the provided name.  Each level of a subtest name becomes a
+// directory, and characters that are not safe in file names are replaced.
+func goldenPathFor(testName string, name string) string {
+	elems := []string{goldenDir}
+
+	for _, elem := range strings.Split(testName, "/") {
+		elems = append(elems, goldenFileNameFor(elem))
+	}
+
+	elems = append(elems, goldenFileNameFor(name)+".golden")
+
+	return filepath.Join(elems...)
+}
+
+// goldenFileNameFor replaces every character of s other than letters, digits,
+// underscores, hyphens and dots with an underscore, so that s is usable as a
+// single file name on every platform.
+func goldenFileNameFor(s string) string {
+	s = strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+			return r
+		case r == '_' || r == '-' || r == '.':
+			return r
+		}
+
+		return '_'
+	}, s)
+
+	if strings.Trim(s, ".") == "" {
+		return strings.Repeat("_", len(s)+1)
+	}
+
+	return s
+}
+
+// goldenContentFor serializes x for comparison with a golden file.
+func goldenContentFor(x interface{}) ([]byte, error) {
+	switch v := x.(type) {
+	case string:
+		return []byte(v), nil
+	case []byte:
+		return v, nil
+	}
+
+	xv := reflect.ValueOf(x)
+	if xv.Kind() == reflect.String {
+		return []byte(xv.String()), nil
+	}
+
+	if xv.Kind() == reflect.Slice && xv.Type().Elem().Kind() == reflect.Uint8 {
+		return xv.Bytes(), nil
+	}
+
+	b, err := json.MarshalIndent(x, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	return append(b, '\n'), nil
+}
+
+// goldenDiffFor describes how actual differs from golden, using a unified diff
+// from the subject, x, to the golden file, y, when both are text.
+func goldenDiffFor(actual []byte, golden []byte) string {
+	if utf8.Valid(actual) && utf8.Valid(golden) {
+		return unifiedDiff(string(actual), string(golden))
+	}
+
+	i := 0
+	for i < len(actual) && i < len(golden) && actual[i] == golden[i] {
+		i++
+	}
+
+	return fmt.Sprintf("subject: %v bytes\ngolden: %v bytes\nfirst difference at byte %v", len(actual), len(golden), i)
+}
+
+// updatingGoldenFiles reports whether golden files should be rewritten.  The
+// -update flag is never defined by this package, as that would conflict with
+// test packages that define it themselves, but it is honoured if they do.
+func updatingGoldenFiles() bool {
+	if update, err := strconv.ParseBool(os.Getenv(goldenUpdateEnv)); err == nil && update {
+		return true
+	}
+
+	f := flag.Lookup("update")
+	if f == nil {
+		return false
+	}
+
+	update, err := strconv.ParseBool(f.Value.String())
+	return err == nil && update
+}
+
+func writeGoldenFile(path string, content []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, content, 0o644)
+}
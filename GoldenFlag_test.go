@@ -0,0 +1,56 @@
+package test_test
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ljpx/test"
+)
+
+// update is defined as test packages that use golden files commonly do, which
+// must not conflict with this package.
+var update = flag.Bool("update", false, "rewrite golden files")
+
+func TestMatchesGoldenWithUpdateFlagDefinedByTestPackage(t *testing.T) {
+	// Arrange.
+	chdir(t, t.TempDir())
+	t.Setenv("UPDATE_GOLDEN", "")
+
+	previous := *update
+	*update = true
+	t.Cleanup(func() { *update = previous })
+
+	// Act.
+	test.That(t, "fresh\n").MatchesGolden("report")
+
+	// Assert.
+	b, err := os.ReadFile(filepath.Join("testdata", t.Name(), "report.golden"))
+	if err != nil {
+		t.Fatalf("Expected golden file to be written but %v", err)
+	}
+
+	if string(b) != "fresh\n" {
+		t.Fatalf("Expected golden file to be %q but was %q", "fresh\n", string(b))
+	}
+}
+
+func chdir(t *testing.T, dir string) {
+	t.Helper()
+
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		if err := os.Chdir(wd); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
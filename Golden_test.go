@@ -0,0 +1,173 @@
+package test
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestMatchesGolden(t *testing.T) {
+	disableGoldenUpdates(t)
+	useGoldenDir(t)
+	writeGolden(t, "Recorder/report.golden", "total: 3\nfailed: 1\n")
+
+	testCases := []struct {
+		x    interface{}
+		pass bool
+	}{
+		{x: "total: 3\nfailed: 1\n", pass: true},
+		{x: []byte("total: 3\nfailed: 1\n"), pass: true},
+		{x: "total: 3\nfailed: 2\n", pass: false},
+	}
+
+	for _, testCase := range testCases {
+		recorder := NewRecorder()
+		That(recorder, testCase.x).MatchesGolden("report")
+
+		if !testCase.pass {
+			assertFailed(t, recorder)
+			assertHelperCount(t, recorder, 3)
+			assertFailureMessage(t, recorder, "Expected subject to match golden file %s, but it differs\n\n--- x\n+++ y\n@@ -1,3 +1,3 @@\n total: 3\n-failed: [-2-]\n+failed: {+1+}", filepath.Join(goldenDir, "Recorder", "report.golden"))
+		} else {
+			assertPassed(t, recorder)
+			assertHelperCount(t, recorder, 2)
+		}
+	}
+}
+
+func TestMatchesGoldenSerializesValuesAsJSON(t *testing.T) {
+	// Arrange.
+	disableGoldenUpdates(t)
+	useGoldenDir(t)
+	writeGolden(t, "Recorder/user.golden", "{\n  \"name\": \"ada\",\n  \"roles\": [\n    \"admin\"\n  ]\n}\n")
+
+	type user struct {
+		Name  string   `json:"name"`
+		Roles []string `json:"roles"`
+	}
+
+	// Act.
+	recorder := NewRecorder()
+	That(recorder, user{Name: "ada", Roles: []string{"admin"}}).MatchesGolden("user")
+
+	// Assert.
+	assertPassed(t, recorder)
+}
+
+func TestMatchesGoldenMissingFile(t *testing.T) {
+	// Arrange.
+	disableGoldenUpdates(t)
+	useGoldenDir(t)
+
+	// Act.
+	recorder := NewRecorder()
+	That(recorder, "output").Not().MatchesGolden("missing")
+
+	// Assert.
+	assertFailed(t, recorder)
+	assertHelperCount(t, recorder, 3)
+	assertFailureMessage(t, recorder, "Expected golden file %s to exist, but it did not\n\nRun the test with UPDATE_GOLDEN=true to create it", filepath.Join(goldenDir, "Recorder", "missing.golden"))
+}
+
+func TestMatchesGoldenUnserializableSubject(t *testing.T) {
+	// Arrange.
+	disableGoldenUpdates(t)
+	useGoldenDir(t)
+
+	// Act.
+	recorder := NewRecorder()
+	That(recorder, make(chan int)).MatchesGolden("channel")
+
+	// Assert.
+	assertFailed(t, recorder)
+	assertFailureMessage(t, recorder, "Expected a subject that can be written to a golden file, but it could not be serialized: json: unsupported type: chan int\nx: chan int")
+}
+
+func TestMatchesGoldenUpdate(t *testing.T) {
+	// Arrange.
+	disableGoldenUpdates(t)
+	useGoldenDir(t)
+	writeGolden(t, "Recorder/report.golden", "stale\n")
+	t.Setenv("UPDATE_GOLDEN", "true")
+
+	// Act.
+	recorder := NewRecorder()
+	That(recorder, "fresh\n").MatchesGolden("report")
+	That(recorder, "created\n").MatchesGolden("created")
+
+	// Assert.
+	assertPassed(t, recorder)
+
+	for name, expected := range map[string]string{"report": "fresh\n", "created": "created\n"} {
+		b, err := os.ReadFile(filepath.Join(goldenDir, "Recorder", name+".golden"))
+		if err != nil {
+			t.Fatalf("Expected golden file %v to be written but %v", name, err)
+		}
+
+		if string(b) != expected {
+			t.Fatalf("Expected golden file %v to be %q but was %q", name, expected, string(b))
+		}
+	}
+}
+
+func TestGoldenPathFor(t *testing.T) {
+	testCases := []struct {
+		testName string
+		name     string
+		path     string
+	}{
+		{testName: "TestRender", name: "page", path: "testdata/TestRender/page.golden"},
+		{testName: "TestRender/empty_list", name: "page", path: "testdata/TestRender/empty_list/page.golden"},
+		{testName: "TestRender/a/b", name: "page.html", path: "testdata/TestRender/a/b/page.html.golden"},
+		{testName: "TestRender/what?*:", name: "../page", path: "testdata/TestRender/what___/.._page.golden"},
+		{testName: "TestRender/..", name: "page", path: "testdata/TestRender/___/page.golden"},
+	}
+
+	for _, testCase := range testCases {
+		path := goldenPathFor(testCase.testName, testCase.name)
+
+		if path != filepath.FromSlash(testCase.path) {
+			t.Fatalf("Expected goldenPathFor(%q, %q) to be %q but was %q", testCase.testName, testCase.name, testCase.path, path)
+		}
+	}
+}
+
+// disableGoldenUpdates stops golden files and inline snapshots being updated
+// for the duration of the test, even if the tests of this package are being
+// run to update them.
+func disableGoldenUpdates(t *testing.T) {
+	t.Helper()
+
+	t.Setenv(goldenUpdateEnv, "false")
+
+	if f := flag.Lookup("update"); f != nil {
+		value := f.Value.String()
+		t.Cleanup(func() { f.Value.Set(value) })
+
+		if err := f.Value.Set("false"); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func useGoldenDir(t *testing.T) {
+	t.Helper()
+
+	dir := goldenDir
+	goldenDir = t.TempDir()
+	t.Cleanup(func() { goldenDir = dir })
+}
+
+func writeGolden(t *testing.T, name string, content string) {
+	t.Helper()
+
+	path := filepath.Join(goldenDir, filepath.FromSlash(name))
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
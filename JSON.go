@@ -0,0 +1,470 @@
+package test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// IsValidJSON fails the test if the subject, x, is not a single valid JSON
+// value.  The subject may be a string, []byte, json.RawMessage or io.Reader.
+func (a *Assertions) IsValidJSON() {
+	a.t.Helper()
+
+	if m, failed := a.evaluate(isValidJSON()); failed {
+		formattedFailure(a, m.format, m.args...)
+	}
+}
+
+// EqualsJSON fails the test if the subject, x, is not JSON semantically equal
+// to y.  Objects are compared regardless of the order of their keys, numbers
+// are compared by value so that 1, 1.0 and 1e0 are equal, and whitespace is
+// ignored.  y may be JSON in any of the forms accepted for the subject by
+// IsValidJSON, or any other value, which is marshalled with encoding/json.
+// Every path at which the documents differ is reported.
+func (a *Assertions) EqualsJSON(y interface{}) {
+	a.t.Helper()
+
+	if m, failed := a.evaluate(equalsJSON(y)); failed {
+		formattedFailure(a, m.format, m.args...)
+	}
+}
+
+// HasJSONPath fails the test if the subject, x, is JSON that has no value at
+// path.  Paths start with $ for the whole document, followed by any number of
+// object members, as in .name or ["name"], and array elements, as in [0].
+func (a *Assertions) HasJSONPath(path string) {
+	a.t.Helper()
+
+	if m, failed := a.evaluate(hasJSONPath(path)); failed {
+		formattedFailure(a, m.format, m.args...)
+	}
+}
+
+// JSONPathEquals fails the test if the subject, x, is JSON whose value at
+// path, as for HasJSONPath, is not semantically equal to v as for EqualsJSON.
+// v is always marshalled with encoding/json, so a string is compared with a
+// JSON string; use json.RawMessage to provide the value as JSON.
+func (a *Assertions) JSONPathEquals(path string, v interface{}) {
+	a.t.Helper()
+
+	if m, failed := a.evaluate(jsonPathEquals(path, v)); failed {
+		formattedFailure(a, m.format, m.args...)
+	}
+}
+
+func isValidJSON() predicate {
+	return withJSONText(func(text string) outcome {
+		if _, err := parseJSON(text); err != nil {
+			return failed("Expected %v to be valid JSON, but it was not: %s", text, err)
+		}
+
+		return passed("Expected %s to not be valid JSON, but it was", compactJSON(text))
+	})
+}
+
+func equalsJSON(y interface{}) predicate {
+	return withJSON(func(text string, doc interface{}) outcome {
+		expected, err := jsonDocumentFor(y)
+		if err != nil {
+			return invalid("Expected comparator to be valid JSON, but it was not: %s\ny: %s", err, typeNameFor(y))
+		}
+
+		diffs := jsonDiff("$", doc, expected)
+		if len(diffs) == 0 {
+			return passed("Expected %s to not equal JSON %s", compactJSON(text), formatJSON(expected))
+		}
+
+		return failed("Expected %s to equal JSON %s\n\n%s", compactJSON(text), formatJSON(expected), strings.Join(diffs, "\n"))
+	})
+}
+
+func hasJSONPath(path string) predicate {
+	return withJSONPath(path, func(text string, found interface{}, missing string) outcome {
+		if missing != "" {
+			return failed("Expected %s to have JSON path %s, but %s did not exist", compactJSON(text), path, missing)
+		}
+
+		return passed("Expected %s to not have JSON path %s, but it had %s", compactJSON(text), path, formatJSON(found))
+	})
+}
+
+func jsonPathEquals(path string, v interface{}) predicate {
+	return withJSONPath(path, func(text string, found interface{}, missing string) outcome {
+		expected, err := jsonValueFor(v)
+		if err != nil {
+			return invalid("Expected a value that can be marshalled as JSON, but it could not be: %s\nv: %s", err, typeNameFor(v))
+		}
+
+		if missing != "" {
+			return failed("Expected %s to have JSON path %s equal to %s, but %s did not exist", compactJSON(text), path, formatJSON(expected), missing)
+		}
+
+		diffs := jsonDiff(path, found, expected)
+		if len(diffs) == 0 {
+			return passed("Expected JSON path %s of %s to not equal %s, but it did", path, compactJSON(text), formatJSON(expected))
+		}
+
+		return failed("Expected JSON path %s of %s to equal %s\n\n%s", path, compactJSON(text), formatJSON(expected), strings.Join(diffs, "\n"))
+	})
+}
+
+// withJSONText returns a predicate that applies p to the subject as JSON
+// text.  The outcome is invalid if the subject cannot be read as text.
+func withJSONText(p func(text string) outcome) predicate {
+	return func(x interface{}) outcome {
+		text, ok, err := baseDocumentText(x)
+		if !ok {
+			return invalid("Expected a string, []byte, json.RawMessage or io.Reader, but was not\nx: %s", typeNameFor(x))
+		}
+
+		if err != nil {
+			return invalid("Expected subject to be readable, but it was not: %s\nx: %s", err, typeNameFor(x))
+		}
+
+		return p(text)
+	}
+}
+
+// withJSON returns a predicate that applies p to the subject as JSON text and
+// as the document it encodes.  The outcome is invalid if the subject is not
+// valid JSON.
+func withJSON(p func(text string, doc interface{}) outcome) predicate {
+	return withJSONText(func(text string) outcome {
+		doc, err := parseJSON(text)
+		if err != nil {
+			return invalid("Expected %v to be valid JSON, but it was not: %s", text, err)
+		}
+
+		return p(text, doc)
+	})
+}
+
+// withJSONPath returns a predicate that applies p to the subject as JSON text
+// and the value at path within it.  If there is no such value, missing is the
+// shortest prefix of path that does not exist.  The outcome is invalid if the
+// subject is not valid JSON or the path cannot be parsed.
+func withJSONPath(path string, p func(text string, found interface{}, missing string) outcome) predicate {
+	return withJSON(func(text string, doc interface{}) outcome {
+		steps, err := parseJSONPath(path)
+		if err != nil {
+			return invalid("Expected a valid JSON path, but %q was not: %s", path, err)
+		}
+
+		found, missing := lookupJSONPath(doc, steps)
+		return p(text, found, missing)
+	})
+}
+
+// baseDocumentText returns the text of a document provided as a string,
+// []byte, json.RawMessage or io.Reader, reading the reader to its end.
+func baseDocumentText(x interface{}) (string, bool, error) {
+	switch v := x.(type) {
+	case string:
+		return v, true, nil
+	case []byte:
+		return string(v), true, nil
+	case json.RawMessage:
+		return string(v), true, nil
+	case io.Reader:
+		b, err := io.ReadAll(v)
+		return string(b), true, err
+	}
+
+	return "", false, nil
+}
+
+// parseJSON decodes the single JSON value in text.  Numbers are decoded as
+// json.Number so that they can be compared without loss of precision.
+func parseJSON(text string) (interface{}, error) {
+	dec := json.NewDecoder(strings.NewReader(text))
+	dec.UseNumber()
+
+	var doc interface{}
+	if err := dec.Decode(&doc); err != nil {
+		if err == io.EOF {
+			return nil, io.ErrUnexpectedEOF
+		}
+
+		return nil, err
+	}
+
+	if _, err := dec.Token(); err != io.EOF {
+		return nil, errors.New("unexpected data after top-level value")
+	}
+
+	return doc, nil
+}
+
+// jsonDocumentFor returns the JSON document encoded by y if it is JSON text,
+// and otherwise the document that y is marshalled as.
+func jsonDocumentFor(y interface{}) (interface{}, error) {
+	text, ok, err := baseDocumentText(y)
+	if !ok {
+		return jsonValueFor(y)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return parseJSON(text)
+}
+
+// jsonValueFor returns the JSON document that v is marshalled as.
+func jsonValueFor(v interface{}) (interface{}, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseJSON(string(b))
+}
+
+// jsonDiff compares the JSON documents x and y and returns a description of
+// every path at which they differ.
+func jsonDiff(path string, x interface{}, y interface{}) []string {
+	report := func() []string {
+		return []string{fmt.Sprintf("%v: %v != %v", path, formatJSON(x), formatJSON(y))}
+	}
+
+	switch xv := x.(type) {
+	case map[string]interface{}:
+		yv, ok := y.(map[string]interface{})
+		if !ok {
+			return report()
+		}
+
+		keys := make([]string, 0, len(xv)+len(yv))
+		for key := range xv {
+			keys = append(keys, key)
+		}
+
+		for key := range yv {
+			if _, ok := xv[key]; !ok {
+				keys = append(keys, key)
+			}
+		}
+
+		sort.Strings(keys)
+
+		var diffs []string
+		for _, key := range keys {
+			memberPath := jsonMemberPath(path, key)
+			xe, xok := xv[key]
+			ye, yok := yv[key]
+
+			switch {
+			case !xok:
+				diffs = append(diffs, fmt.Sprintf("%v: <missing> != %v", memberPath, formatJSON(ye)))
+			case !yok:
+				diffs = append(diffs, fmt.Sprintf("%v: %v != <missing>", memberPath, formatJSON(xe)))
+			default:
+				diffs = append(diffs, jsonDiff(memberPath, xe, ye)...)
+			}
+		}
+
+		return diffs
+
+	case []interface{}:
+		yv, ok := y.([]interface{})
+		if !ok {
+			return report()
+		}
+
+		var diffs []string
+		for i := 0; i < len(xv) || i < len(yv); i++ {
+			elementPath := fmt.Sprintf("%v[%v]", path, i)
+
+			switch {
+			case i >= len(xv):
+				diffs = append(diffs, fmt.Sprintf("%v: <missing> != %v", elementPath, formatJSON(yv[i])))
+			case i >= len(yv):
+				diffs = append(diffs, fmt.Sprintf("%v: %v != <missing>", elementPath, formatJSON(xv[i])))
+			default:
+				diffs = append(diffs, jsonDiff(elementPath, xv[i], yv[i])...)
+			}
+		}
+
+		return diffs
+
+	case json.Number:
+		yv, ok := y.(json.Number)
+		if !ok || !jsonNumbersEqual(xv, yv) {
+			return report()
+		}
+
+		return nil
+	}
+
+	if x != y {
+		return report()
+	}
+
+	return nil
+}
+
+func jsonNumbersEqual(x json.Number, y json.Number) bool {
+	xr, ok1 := new(big.Rat).SetString(string(x))
+	yr, ok2 := new(big.Rat).SetString(string(y))
+	if !ok1 || !ok2 {
+		return x == y
+	}
+
+	return xr.Cmp(yr) == 0
+}
+
+var jsonIdentifierRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// jsonMemberPath returns the path of the member key of the object at path.
+func jsonMemberPath(path string, key string) string {
+	if jsonIdentifierRegexp.MatchString(key) {
+		return path + "." + key
+	}
+
+	return path + "[" + strconv.Quote(key) + "]"
+}
+
+// jsonPathStep is a single object member or array element in a JSON path.
+type jsonPathStep struct {
+	key     string
+	index   int
+	isIndex bool
+}
+
+// parseJSONPath parses a path of the form accepted by HasJSONPath.
+func parseJSONPath(path string) ([]jsonPathStep, error) {
+	if !strings.HasPrefix(path, "$") {
+		return nil, errors.New("it must start with $")
+	}
+
+	var steps []jsonPathStep
+	rest := path[1:]
+
+	for rest != "" {
+		switch rest[0] {
+		case '.':
+			end := strings.IndexAny(rest[1:], ".[")
+			if end < 0 {
+				end = len(rest) - 1
+			}
+
+			key := rest[1 : end+1]
+			if key == "" {
+				return nil, fmt.Errorf("expected a member name at %q", rest)
+			}
+
+			steps = append(steps, jsonPathStep{key: key})
+			rest = rest[end+1:]
+
+		case '[':
+			end := strings.Index(rest, "]")
+			if end < 0 {
+				return nil, fmt.Errorf("expected ] to close %q", rest)
+			}
+
+			step, err := parseJSONPathSubscript(rest[1:end])
+			if err != nil {
+				return nil, err
+			}
+
+			steps = append(steps, step)
+			rest = rest[end+1:]
+
+		default:
+			return nil, fmt.Errorf("expected . or [ at %q", rest)
+		}
+	}
+
+	return steps, nil
+}
+
+func parseJSONPathSubscript(s string) (jsonPathStep, error) {
+	if len(s) >= 2 && (s[0] == '"' || s[0] == '\'') && s[len(s)-1] == s[0] {
+		if s[0] == '\'' {
+			return jsonPathStep{key: s[1 : len(s)-1]}, nil
+		}
+
+		key, err := strconv.Unquote(s)
+		if err != nil {
+			return jsonPathStep{}, fmt.Errorf("invalid member name %s", s)
+		}
+
+		return jsonPathStep{key: key}, nil
+	}
+
+	index, err := strconv.Atoi(s)
+	if err != nil || index < 0 {
+		return jsonPathStep{}, fmt.Errorf("expected a quoted member name or non-negative index in [%s]", s)
+	}
+
+	return jsonPathStep{index: index, isIndex: true}, nil
+}
+
+// lookupJSONPath returns the value at the end of steps within doc.  If there
+// is no such value, it returns the path of the first step that is missing.
+func lookupJSONPath(doc interface{}, steps []jsonPathStep) (interface{}, string) {
+	path := "$"
+	current := doc
+
+	for _, step := range steps {
+		if step.isIndex {
+			path = fmt.Sprintf("%v[%v]", path, step.index)
+
+			elements, ok := current.([]interface{})
+			if !ok || step.index >= len(elements) {
+				return nil, path
+			}
+
+			current = elements[step.index]
+			continue
+		}
+
+		path = jsonMemberPath(path, step.key)
+
+		members, ok := current.(map[string]interface{})
+		if !ok {
+			return nil, path
+		}
+
+		member, ok := members[step.key]
+		if !ok {
+			return nil, path
+		}
+
+		current = member
+	}
+
+	return current, ""
+}
+
+// formatJSON prints the JSON document v compactly.
+func formatJSON(v interface{}) string {
+	buf := &bytes.Buffer{}
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+
+	if err := enc.Encode(v); err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+
+	return strings.TrimSuffix(buf.String(), "\n")
+}
+
+// compactJSON removes insignificant whitespace from the JSON text s, or
+// returns s unchanged if it is not valid JSON.
+func compactJSON(s string) string {
+	buf := &bytes.Buffer{}
+	if err := json.Compact(buf, []byte(s)); err != nil {
+		return s
+	}
+
+	return buf.String()
+}
@@ -0,0 +1,738 @@
+package test
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// ConformsToJSONSchema fails the test if the subject, x, is not JSON that is
+// valid against schema, which may be provided in any of the forms accepted by
+// EqualsJSON.  Schemas are interpreted according to draft 2020-12, supporting
+// the type, enum, const, numeric, string, array and object keywords,
+// dependentRequired, dependentSchemas, allOf, anyOf, oneOf, not, if, then and
+// else, and references within the schema to JSON pointers such as
+// "#/$defs/item" or to anchors defined with $anchor.  Schemas are never
+// fetched over the network, so references to other documents are not
+// supported, and neither are formats, which are annotations by default.
+// Schemas that use unevaluatedItems, unevaluatedProperties, $dynamicRef,
+// $dynamicAnchor or $id below the root are reported as invalid rather than
+// validated incorrectly.  Every violation is reported with the JSON pointer of
+// the value that caused it and of the keyword that it violates.
+func (a *Assertions) ConformsToJSONSchema(schema interface{}) {
+	a.t.Helper()
+
+	if m, failed := a.evaluate(conformsToJSONSchema(schema)); failed {
+		formattedFailure(a, m.format, m.args...)
+	}
+}
+
+func conformsToJSONSchema(schema interface{}) predicate {
+	return withJSON(func(text string, doc interface{}) outcome {
+		root, err := jsonDocumentFor(schema)
+		if err != nil {
+			return invalid("Expected a JSON schema, but it was not valid JSON: %s\nschema: %s", err, typeNameFor(schema))
+		}
+
+		violations, err := validateJSONSchema(root, doc)
+		if err != nil {
+			return invalid("Expected a valid JSON schema, but it was not: %s", err)
+		}
+
+		if len(violations) == 0 {
+			return passed("Expected %s to not conform to the JSON schema, but it did", compactJSON(text))
+		}
+
+		lines := make([]string, len(violations))
+		for i, violation := range violations {
+			lines[i] = violation.String()
+		}
+
+		noun := "violations"
+		if len(violations) == 1 {
+			noun = "violation"
+		}
+
+		return failed("Expected %s to conform to the JSON schema, but it had %v %s\n\n%s", compactJSON(text), len(violations), noun, strings.Join(lines, "\n"))
+	})
+}
+
+// schemaViolation is a failure of an instance to satisfy a schema keyword.
+// Both locations are JSON pointers in URI fragment form, such as "#/items/0".
+type schemaViolation struct {
+	instance string
+	keyword  string
+	message  string
+}
+
+func (v schemaViolation) String() string {
+	return fmt.Sprintf("%v: %v (%v)", v.instance, v.message, v.keyword)
+}
+
+// unsupportedKeywords are the keywords of draft 2020-12 that change the result
+// of validation but are not supported.  Schemas that use them are invalid, so
+// that they are never silently ignored.
+var unsupportedKeywords = []string{"$dynamicAnchor", "$dynamicRef", "unevaluatedItems", "unevaluatedProperties"}
+
+// schemaError is raised with panic by the validator when the schema itself is
+// invalid, and recovered by validateJSONSchema.
+type schemaError struct {
+	err error
+}
+
+// validateJSONSchema validates instance against the schema root and returns
+// every violation, or an error if root is not a valid schema.
+func validateJSONSchema(root interface{}, instance interface{}) (violations []schemaViolation, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			se, ok := r.(schemaError)
+			if !ok {
+				panic(r)
+			}
+
+			violations, err = nil, se.err
+		}
+	}()
+
+	v := &schemaValidator{
+		root:     root,
+		patterns: make(map[string]*regexp.Regexp),
+		active:   make(map[string]bool),
+	}
+
+	return v.validate(root, instance, "#", "#"), nil
+}
+
+type schemaValidator struct {
+	root     interface{}
+	patterns map[string]*regexp.Regexp
+
+	// active records the references being followed for each instance
+	// location, so that references that never consume any of the instance
+	// are detected rather than followed forever.
+	active map[string]bool
+}
+
+func (v *schemaValidator) fail(schemaAt string, format string, args ...interface{}) {
+	panic(schemaError{err: fmt.Errorf("%v: %v", schemaAt, fmt.Sprintf(format, args...))})
+}
+
+// validate validates the instance at the location at against the schema at
+// the location schemaAt.
+func (v *schemaValidator) validate(schema interface{}, instance interface{}, at string, schemaAt string) []schemaViolation {
+	var s map[string]interface{}
+
+	switch schema := schema.(type) {
+	case bool:
+		if schema {
+			return nil
+		}
+
+		return []schemaViolation{{instance: at, keyword: schemaAt, message: fmt.Sprintf("expected no value, but was %v", formatJSON(instance))}}
+
+	case map[string]interface{}:
+		s = schema
+
+	default:
+		v.fail(schemaAt, "expected a schema to be an object or boolean, but was %v", formatJSON(schema))
+	}
+
+	for _, keyword := range unsupportedKeywords {
+		if _, ok := s[keyword]; ok {
+			v.fail(schemaAt+"/"+keyword, "unsupported keyword %q", keyword)
+		}
+	}
+
+	if _, ok := s["$id"]; ok && schemaAt != "#" {
+		v.fail(schemaAt+"/$id", "unsupported keyword \"$id\" below the root of the schema")
+	}
+
+	var violations []schemaViolation
+	report := func(keyword string, format string, args ...interface{}) {
+		violations = append(violations, schemaViolation{instance: at, keyword: schemaAt + "/" + keyword, message: fmt.Sprintf(format, args...)})
+	}
+
+	if ref, ok := s["$ref"]; ok {
+		violations = append(violations, v.validateRef(ref, instance, at, schemaAt+"/$ref")...)
+	}
+
+	v.validateGeneric(s, instance, schemaAt, report)
+
+	// The violations of subschemas are appended only once each call returns,
+	// as report appends to violations during the call.
+	var nested []schemaViolation
+
+	switch instance := instance.(type) {
+	case json.Number:
+		v.validateNumber(s, instance, schemaAt, report)
+	case string:
+		v.validateString(s, instance, schemaAt, report)
+	case []interface{}:
+		nested = v.validateArray(s, instance, at, schemaAt, report)
+	case map[string]interface{}:
+		nested = v.validateObject(s, instance, at, schemaAt, report)
+	}
+
+	violations = append(violations, nested...)
+
+	nested = v.validateComposition(s, instance, at, schemaAt, report)
+	violations = append(violations, nested...)
+
+	return violations
+}
+
+func (v *schemaValidator) validateRef(ref interface{}, instance interface{}, at string, schemaAt string) []schemaViolation {
+	pointer, ok := ref.(string)
+	if !ok {
+		v.fail(schemaAt, "expected a string, but was %v", formatJSON(ref))
+	}
+
+	target := v.resolve(pointer, schemaAt)
+
+	key := pointer + " " + at
+	if v.active[key] {
+		v.fail(schemaAt, "reference %q is circular", pointer)
+	}
+
+	v.active[key] = true
+	defer delete(v.active, key)
+
+	return v.validate(target, instance, at, pointer)
+}
+
+// resolve returns the schema referred to by the JSON pointer in URI fragment
+// form, such as "#/$defs/item", or by the anchor, such as "#item".
+func (v *schemaValidator) resolve(ref string, schemaAt string) interface{} {
+	if !strings.HasPrefix(ref, "#") {
+		v.fail(schemaAt, "unsupported reference %q; only JSON pointers and anchors within the schema, such as \"#/$defs/name\" and \"#name\", are supported", ref)
+	}
+
+	if len(ref) > 1 && ref[1] != '/' {
+		return v.anchor(ref, schemaAt)
+	}
+
+	fragment, err := url.PathUnescape(ref[1:])
+	if err != nil {
+		v.fail(schemaAt, "invalid reference %q: %v", ref, err)
+	}
+
+	current := v.root
+	if fragment == "" {
+		return current
+	}
+
+	for _, token := range strings.Split(fragment[1:], "/") {
+		token = strings.NewReplacer("~1", "/", "~0", "~").Replace(token)
+
+		switch node := current.(type) {
+		case map[string]interface{}:
+			next, ok := node[token]
+			if !ok {
+				v.fail(schemaAt, "reference %q could not be resolved", ref)
+			}
+
+			current = next
+
+		case []interface{}:
+			i, err := strconv.Atoi(token)
+			if err != nil || i < 0 || i >= len(node) {
+				v.fail(schemaAt, "reference %q could not be resolved", ref)
+			}
+
+			current = node[i]
+
+		default:
+			v.fail(schemaAt, "reference %q could not be resolved", ref)
+		}
+	}
+
+	return current
+}
+
+// anchor returns the schema that defines the anchor referred to by ref, such as
+// "#item" for a schema with "$anchor": "item".
+func (v *schemaValidator) anchor(ref string, schemaAt string) interface{} {
+	var found []interface{}
+
+	var walk func(node interface{})
+	walk = func(node interface{}) {
+		switch node := node.(type) {
+		case map[string]interface{}:
+			if name, ok := node["$anchor"].(string); ok && "#"+name == ref {
+				found = append(found, node)
+			}
+
+			for key, child := range node {
+				if key != "enum" && key != "const" {
+					walk(child)
+				}
+			}
+
+		case []interface{}:
+			for _, child := range node {
+				walk(child)
+			}
+		}
+	}
+
+	walk(v.root)
+
+	switch len(found) {
+	case 0:
+		v.fail(schemaAt, "reference %q could not be resolved", ref)
+	case 1:
+	default:
+		v.fail(schemaAt, "reference %q is ambiguous, as the anchor is defined %v times", ref, len(found))
+	}
+
+	return found[0]
+}
+
+func (v *schemaValidator) validateGeneric(s map[string]interface{}, instance interface{}, schemaAt string, report func(keyword string, format string, args ...interface{})) {
+	if t, ok := s["type"]; ok {
+		var types []string
+
+		switch t := t.(type) {
+		case string:
+			types = []string{t}
+		case []interface{}:
+			for _, e := range t {
+				name, ok := e.(string)
+				if !ok {
+					v.fail(schemaAt+"/type", "expected an array of strings, but was %v", formatJSON(t))
+				}
+
+				types = append(types, name)
+			}
+		default:
+			v.fail(schemaAt+"/type", "expected a string or array of strings, but was %v", formatJSON(t))
+		}
+
+		if !jsonHasAnyType(instance, types) {
+			report("type", "expected %v, but was %v", strings.Join(types, " or "), jsonTypeOf(instance))
+		}
+	}
+
+	if enum, ok := s["enum"]; ok {
+		values := v.array(enum, schemaAt+"/enum")
+
+		matched := false
+		for _, value := range values {
+			if len(jsonDiff("", instance, value)) == 0 {
+				matched = true
+				break
+			}
+		}
+
+		if !matched {
+			report("enum", "expected one of %v, but was %v", formatJSON(enum), formatJSON(instance))
+		}
+	}
+
+	if c, ok := s["const"]; ok && len(jsonDiff("", instance, c)) > 0 {
+		report("const", "expected %v, but was %v", formatJSON(c), formatJSON(instance))
+	}
+}
+
+func (v *schemaValidator) validateNumber(s map[string]interface{}, instance json.Number, schemaAt string, report func(keyword string, format string, args ...interface{})) {
+	x := v.number(instance, "#")
+
+	bounds := []struct {
+		keyword  string
+		relation string
+		accept   func(c int) bool
+	}{
+		{keyword: "minimum", relation: "at least", accept: func(c int) bool { return c >= 0 }},
+		{keyword: "maximum", relation: "at most", accept: func(c int) bool { return c <= 0 }},
+		{keyword: "exclusiveMinimum", relation: "greater than", accept: func(c int) bool { return c > 0 }},
+		{keyword: "exclusiveMaximum", relation: "less than", accept: func(c int) bool { return c < 0 }},
+	}
+
+	for _, bound := range bounds {
+		if limit, ok := s[bound.keyword]; ok && !bound.accept(x.Cmp(v.number(limit, schemaAt+"/"+bound.keyword))) {
+			report(bound.keyword, "expected a number %v %v, but was %v", bound.relation, limit, instance)
+		}
+	}
+
+	if m, ok := s["multipleOf"]; ok {
+		divisor := v.number(m, schemaAt+"/multipleOf")
+		if divisor.Sign() <= 0 {
+			v.fail(schemaAt+"/multipleOf", "expected a number greater than 0, but was %v", m)
+		}
+
+		if !new(big.Rat).Quo(x, divisor).IsInt() {
+			report("multipleOf", "expected a multiple of %v, but was %v", m, instance)
+		}
+	}
+}
+
+func (v *schemaValidator) validateString(s map[string]interface{}, instance string, schemaAt string, report func(keyword string, format string, args ...interface{})) {
+	n := utf8.RuneCountInString(instance)
+
+	if min, ok := s["minLength"]; ok && n < v.count(min, schemaAt+"/minLength") {
+		report("minLength", "expected at least %v characters, but had %v", min, n)
+	}
+
+	if max, ok := s["maxLength"]; ok && n > v.count(max, schemaAt+"/maxLength") {
+		report("maxLength", "expected at most %v characters, but had %v", max, n)
+	}
+
+	if pattern, ok := s["pattern"]; ok && !v.pattern(pattern, schemaAt+"/pattern").MatchString(instance) {
+		report("pattern", "expected a string matching %v, but was %v", formatJSON(pattern), formatJSON(instance))
+	}
+}
+
+func (v *schemaValidator) validateArray(s map[string]interface{}, instance []interface{}, at string, schemaAt string, report func(keyword string, format string, args ...interface{})) []schemaViolation {
+	var violations []schemaViolation
+
+	prefix := 0
+	if prefixItems, ok := s["prefixItems"]; ok {
+		schemas := v.array(prefixItems, schemaAt+"/prefixItems")
+		for i := 0; i < len(schemas) && i < len(instance); i++ {
+			violations = append(violations, v.validate(schemas[i], instance[i], jsonPointer(at, strconv.Itoa(i)), jsonPointer(schemaAt+"/prefixItems", strconv.Itoa(i)))...)
+		}
+
+		prefix = len(schemas)
+	}
+
+	if items, ok := s["items"]; ok {
+		for i := prefix; i < len(instance); i++ {
+			violations = append(violations, v.validate(items, instance[i], jsonPointer(at, strconv.Itoa(i)), schemaAt+"/items")...)
+		}
+	}
+
+	if min, ok := s["minItems"]; ok && len(instance) < v.count(min, schemaAt+"/minItems") {
+		report("minItems", "expected at least %v items, but had %v", min, len(instance))
+	}
+
+	if max, ok := s["maxItems"]; ok && len(instance) > v.count(max, schemaAt+"/maxItems") {
+		report("maxItems", "expected at most %v items, but had %v", max, len(instance))
+	}
+
+	if unique, ok := s["uniqueItems"]; ok && unique == true {
+	unique:
+		for i := range instance {
+			for j := i + 1; j < len(instance); j++ {
+				if len(jsonDiff("", instance[i], instance[j])) == 0 {
+					report("uniqueItems", "expected unique items, but items %v and %v were equal", i, j)
+					break unique
+				}
+			}
+		}
+	}
+
+	if contains, ok := s["contains"]; ok {
+		matched := 0
+		for _, element := range instance {
+			if len(v.validate(contains, element, at, schemaAt+"/contains")) == 0 {
+				matched++
+			}
+		}
+
+		min := 1
+		if m, ok := s["minContains"]; ok {
+			min = v.count(m, schemaAt+"/minContains")
+		}
+
+		if matched < min {
+			report("contains", "expected at least %v items to match contains, but %v did", min, matched)
+		}
+
+		if m, ok := s["maxContains"]; ok && matched > v.count(m, schemaAt+"/maxContains") {
+			report("maxContains", "expected at most %v items to match contains, but %v did", m, matched)
+		}
+	}
+
+	return violations
+}
+
+func (v *schemaValidator) validateObject(s map[string]interface{}, instance map[string]interface{}, at string, schemaAt string, report func(keyword string, format string, args ...interface{})) []schemaViolation {
+	var violations []schemaViolation
+
+	keys := make([]string, 0, len(instance))
+	for key := range instance {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+	evaluated := make(map[string]bool)
+
+	if properties, ok := s["properties"]; ok {
+		schemas := v.object(properties, schemaAt+"/properties")
+		for _, key := range keys {
+			if schema, ok := schemas[key]; ok {
+				evaluated[key] = true
+				violations = append(violations, v.validate(schema, instance[key], jsonPointer(at, key), jsonPointer(schemaAt+"/properties", key))...)
+			}
+		}
+	}
+
+	if patternProperties, ok := s["patternProperties"]; ok {
+		schemas := v.object(patternProperties, schemaAt+"/patternProperties")
+
+		patterns := make([]string, 0, len(schemas))
+		for pattern := range schemas {
+			patterns = append(patterns, pattern)
+		}
+
+		sort.Strings(patterns)
+
+		for _, pattern := range patterns {
+			patternAt := jsonPointer(schemaAt+"/patternProperties", pattern)
+			re := v.pattern(pattern, patternAt)
+
+			for _, key := range keys {
+				if re.MatchString(key) {
+					evaluated[key] = true
+					violations = append(violations, v.validate(schemas[pattern], instance[key], jsonPointer(at, key), patternAt)...)
+				}
+			}
+		}
+	}
+
+	if additional, ok := s["additionalProperties"]; ok {
+		for _, key := range keys {
+			if evaluated[key] {
+				continue
+			}
+
+			if additional == false {
+				violations = append(violations, schemaViolation{instance: jsonPointer(at, key), keyword: schemaAt + "/additionalProperties", message: "expected no additional properties, but had " + strconv.Quote(key)})
+				continue
+			}
+
+			violations = append(violations, v.validate(additional, instance[key], jsonPointer(at, key), schemaAt+"/additionalProperties")...)
+		}
+	}
+
+	if required, ok := s["required"]; ok {
+		for _, name := range v.array(required, schemaAt+"/required") {
+			key, ok := name.(string)
+			if !ok {
+				v.fail(schemaAt+"/required", "expected an array of strings, but was %v", formatJSON(required))
+			}
+
+			if _, ok := instance[key]; !ok {
+				report("required", "expected property %q, but it was missing", key)
+			}
+		}
+	}
+
+	if dependentRequired, ok := s["dependentRequired"]; ok {
+		dependencies := v.object(dependentRequired, schemaAt+"/dependentRequired")
+		for _, key := range keys {
+			names, ok := dependencies[key]
+			if !ok {
+				continue
+			}
+
+			dependentAt := jsonPointer(schemaAt+"/dependentRequired", key)
+			for _, name := range v.array(names, dependentAt) {
+				dependent, ok := name.(string)
+				if !ok {
+					v.fail(dependentAt, "expected an array of strings, but was %v", formatJSON(names))
+				}
+
+				if _, ok := instance[dependent]; !ok {
+					report(jsonPointer("dependentRequired", key), "expected property %q, as %q was present, but it was missing", dependent, key)
+				}
+			}
+		}
+	}
+
+	if dependentSchemas, ok := s["dependentSchemas"]; ok {
+		schemas := v.object(dependentSchemas, schemaAt+"/dependentSchemas")
+		for _, key := range keys {
+			if schema, ok := schemas[key]; ok {
+				violations = append(violations, v.validate(schema, instance, at, jsonPointer(schemaAt+"/dependentSchemas", key))...)
+			}
+		}
+	}
+
+	if min, ok := s["minProperties"]; ok && len(instance) < v.count(min, schemaAt+"/minProperties") {
+		report("minProperties", "expected at least %v properties, but had %v", min, len(instance))
+	}
+
+	if max, ok := s["maxProperties"]; ok && len(instance) > v.count(max, schemaAt+"/maxProperties") {
+		report("maxProperties", "expected at most %v properties, but had %v", max, len(instance))
+	}
+
+	if names, ok := s["propertyNames"]; ok {
+		for _, key := range keys {
+			violations = append(violations, v.validate(names, key, jsonPointer(at, key), schemaAt+"/propertyNames")...)
+		}
+	}
+
+	return violations
+}
+
+func (v *schemaValidator) validateComposition(s map[string]interface{}, instance interface{}, at string, schemaAt string, report func(keyword string, format string, args ...interface{})) []schemaViolation {
+	var violations []schemaViolation
+
+	if allOf, ok := s["allOf"]; ok {
+		for i, schema := range v.array(allOf, schemaAt+"/allOf") {
+			violations = append(violations, v.validate(schema, instance, at, jsonPointer(schemaAt+"/allOf", strconv.Itoa(i)))...)
+		}
+	}
+
+	if anyOf, ok := s["anyOf"]; ok {
+		schemas := v.array(anyOf, schemaAt+"/anyOf")
+		if len(v.matching(schemas, instance, at, schemaAt+"/anyOf")) == 0 {
+			report("anyOf", "expected a value matching at least one of %v schemas, but it matched none", len(schemas))
+		}
+	}
+
+	if oneOf, ok := s["oneOf"]; ok {
+		schemas := v.array(oneOf, schemaAt+"/oneOf")
+
+		switch matched := v.matching(schemas, instance, at, schemaAt+"/oneOf"); len(matched) {
+		case 0:
+			report("oneOf", "expected a value matching exactly one of %v schemas, but it matched none", len(schemas))
+		case 1:
+		default:
+			report("oneOf", "expected a value matching exactly one of %v schemas, but it matched %v", len(schemas), formatJSON(matched))
+		}
+	}
+
+	if not, ok := s["not"]; ok && len(v.validate(not, instance, at, schemaAt+"/not")) == 0 {
+		report("not", "expected a value not matching the schema, but it matched")
+	}
+
+	if condition, ok := s["if"]; ok {
+		if len(v.validate(condition, instance, at, schemaAt+"/if")) == 0 {
+			if then, ok := s["then"]; ok {
+				violations = append(violations, v.validate(then, instance, at, schemaAt+"/then")...)
+			}
+		} else if otherwise, ok := s["else"]; ok {
+			violations = append(violations, v.validate(otherwise, instance, at, schemaAt+"/else")...)
+		}
+	}
+
+	return violations
+}
+
+// matching returns the indices of the schemas that instance is valid against.
+func (v *schemaValidator) matching(schemas []interface{}, instance interface{}, at string, schemaAt string) []int {
+	var matched []int
+	for i, schema := range schemas {
+		if len(v.validate(schema, instance, at, jsonPointer(schemaAt, strconv.Itoa(i)))) == 0 {
+			matched = append(matched, i)
+		}
+	}
+
+	return matched
+}
+
+func (v *schemaValidator) array(x interface{}, schemaAt string) []interface{} {
+	a, ok := x.([]interface{})
+	if !ok {
+		v.fail(schemaAt, "expected an array, but was %v", formatJSON(x))
+	}
+
+	return a
+}
+
+func (v *schemaValidator) object(x interface{}, schemaAt string) map[string]interface{} {
+	o, ok := x.(map[string]interface{})
+	if !ok {
+		v.fail(schemaAt, "expected an object, but was %v", formatJSON(x))
+	}
+
+	return o
+}
+
+func (v *schemaValidator) number(x interface{}, schemaAt string) *big.Rat {
+	n, ok := x.(json.Number)
+	if !ok {
+		v.fail(schemaAt, "expected a number, but was %v", formatJSON(x))
+	}
+
+	r, ok := new(big.Rat).SetString(string(n))
+	if !ok {
+		v.fail(schemaAt, "expected a number, but was %v", n)
+	}
+
+	return r
+}
+
+func (v *schemaValidator) count(x interface{}, schemaAt string) int {
+	r := v.number(x, schemaAt)
+	if !r.IsInt() || r.Sign() < 0 || !r.Num().IsInt64() {
+		v.fail(schemaAt, "expected a non-negative integer, but was %v", x)
+	}
+
+	return int(r.Num().Int64())
+}
+
+func (v *schemaValidator) pattern(x interface{}, schemaAt string) *regexp.Regexp {
+	pattern, ok := x.(string)
+	if !ok {
+		v.fail(schemaAt, "expected a string, but was %v", formatJSON(x))
+	}
+
+	if re, ok := v.patterns[pattern]; ok {
+		return re
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		v.fail(schemaAt, "invalid pattern %q: %v", pattern, err)
+	}
+
+	v.patterns[pattern] = re
+	return re
+}
+
+// jsonTypeOf returns the JSON Schema type of the JSON document x.
+func jsonTypeOf(x interface{}) string {
+	switch x := x.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case string:
+		return "string"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	case json.Number:
+		if jsonIsInteger(x) {
+			return "integer"
+		}
+	}
+
+	return "number"
+}
+
+func jsonHasAnyType(x interface{}, types []string) bool {
+	actual := jsonTypeOf(x)
+
+	for _, t := range types {
+		if t == actual || (t == "number" && actual == "integer") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// jsonIsInteger reports whether n has no fractional part, so that 1.0 is an
+// integer as JSON Schema requires.
+func jsonIsInteger(n json.Number) bool {
+	r, ok := new(big.Rat).SetString(string(n))
+	return ok && r.IsInt()
+}
+
+// jsonPointer appends token to the JSON pointer in URI fragment form at,
+// escaping it as required.
+func jsonPointer(at string, token string) string {
+	token = strings.NewReplacer("~", "~0", "/", "~1").Replace(token)
+	return at + "/" + (&url.URL{Path: token}).EscapedPath()
+}
@@ -0,0 +1,151 @@
+package test
+
+import "testing"
+
+const orderSchema = `{
+	"$schema": "https://json-schema.org/draft/2020-12/schema",
+	"type": "object",
+	"required": ["id", "status", "items"],
+	"properties": {
+		"id": {"type": "integer", "minimum": 1},
+		"status": {"enum": ["pending", "shipped"]},
+		"email": {"type": "string", "pattern": "^[^@]+@[^@]+$"},
+		"items": {"type": "array", "minItems": 1, "items": {"$ref": "#/$defs/item"}}
+	},
+	"additionalProperties": false,
+	"$defs": {
+		"item": {
+			"type": "object",
+			"required": ["sku", "quantity"],
+			"properties": {
+				"sku": {"type": "string", "minLength": 3},
+				"quantity": {"type": "integer", "exclusiveMinimum": 0, "multipleOf": 1}
+			}
+		}
+	}
+}`
+
+func TestConformsToJSONSchema(t *testing.T) {
+	testCases := []struct {
+		x       string
+		pass    bool
+		message string
+	}{
+		{x: `{"id": 1, "status": "pending", "items": [{"sku": "abc", "quantity": 2.0}]}`, pass: true},
+		{
+			x:    `{"id": 0, "status": "lost", "items": [{"sku": "ab", "quantity": 0}, {}], "note": "x", "email": "nobody"}`,
+			pass: false,
+			message: `to conform to the JSON schema, but it had 8 violations
+
+#/email: expected a string matching "^[^@]+@[^@]+$", but was "nobody" (#/properties/email/pattern)
+#/id: expected a number at least 1, but was 0 (#/properties/id/minimum)
+#/items/0/quantity: expected a number greater than 0, but was 0 (#/$defs/item/properties/quantity/exclusiveMinimum)
+#/items/0/sku: expected at least 3 characters, but had 2 (#/$defs/item/properties/sku/minLength)
+#/items/1: expected property "sku", but it was missing (#/$defs/item/required)
+#/items/1: expected property "quantity", but it was missing (#/$defs/item/required)
+#/status: expected one of ["pending","shipped"], but was "lost" (#/properties/status/enum)
+#/note: expected no additional properties, but had "note" (#/additionalProperties)`,
+		},
+		{x: `{"id": "1", "status": "shipped", "items": []}`, pass: false, message: "#/id: expected integer, but was string (#/properties/id/type)\n#/items: expected at least 1 items, but had 0 (#/properties/items/minItems)"},
+		{x: `[]`, pass: false, message: "but it had 1 violation\n\n#: expected object, but was array (#/type)"},
+	}
+
+	for _, testCase := range testCases {
+		recorder := NewRecorder()
+		That(recorder, testCase.x).ConformsToJSONSchema(orderSchema)
+
+		if !testCase.pass {
+			assertFailed(t, recorder)
+			assertHelperCount(t, recorder, 3)
+			assertFailureMessage(t, recorder, testCase.message)
+		} else {
+			assertPassed(t, recorder)
+			assertHelperCount(t, recorder, 2)
+		}
+	}
+}
+
+func TestConformsToJSONSchemaKeywords(t *testing.T) {
+	testCases := []struct {
+		schema  string
+		x       string
+		pass    bool
+		message string
+	}{
+		{schema: `true`, x: `1`, pass: true},
+		{schema: `false`, x: `1`, pass: false, message: "#: expected no value, but was 1 (#)"},
+		{schema: `{"type": ["string", "null"]}`, x: `null`, pass: true},
+		{schema: `{"type": "number"}`, x: `1`, pass: true},
+		{schema: `{"type": "integer"}`, x: `1.5`, pass: false, message: "#: expected integer, but was number (#/type)"},
+		{schema: `{"const": {"a": [1]}}`, x: `{"a": [1.0]}`, pass: true},
+		{schema: `{"const": 1}`, x: `2`, pass: false, message: "#: expected 1, but was 2 (#/const)"},
+		{schema: `{"maximum": 3, "exclusiveMaximum": 3}`, x: `3`, pass: false, message: "#: expected a number less than 3, but was 3 (#/exclusiveMaximum)"},
+		{schema: `{"multipleOf": 0.1}`, x: `0.3`, pass: true},
+		{schema: `{"multipleOf": 2}`, x: `3`, pass: false, message: "#: expected a multiple of 2, but was 3 (#/multipleOf)"},
+		{schema: `{"maxLength": 2}`, x: `"héé"`, pass: false, message: "#: expected at most 2 characters, but had 3 (#/maxLength)"},
+		{schema: `{"prefixItems": [{"type": "string"}], "items": {"type": "integer"}}`, x: `["a", 1, "b"]`, pass: false, message: "#/2: expected integer, but was string (#/items/type)"},
+		{schema: `{"uniqueItems": true}`, x: `[1, 2, 1.0]`, pass: false, message: "#: expected unique items, but items 0 and 2 were equal (#/uniqueItems)"},
+		{schema: `{"contains": {"const": 1}, "maxContains": 1}`, x: `[1, 1]`, pass: false, message: "#: expected at most 1 items to match contains, but 2 did (#/maxContains)"},
+		{schema: `{"contains": {"const": 1}}`, x: `[2]`, pass: false, message: "#: expected at least 1 items to match contains, but 0 did (#/contains)"},
+		{schema: `{"patternProperties": {"^x-": {"type": "string"}}, "additionalProperties": {"type": "integer"}}`, x: `{"x-a": 1, "b": "c"}`, pass: false, message: "#/x-a: expected string, but was integer (#/patternProperties/%%5Ex-/type)\n#/b: expected integer, but was string (#/additionalProperties/type)"},
+		{schema: `{"propertyNames": {"maxLength": 1}, "maxProperties": 1}`, x: `{"ab": 1, "c": 2}`, pass: false, message: "#: expected at most 1 properties, but had 2 (#/maxProperties)\n#/ab: expected at most 1 characters, but had 2 (#/propertyNames/maxLength)"},
+		{schema: `{"properties": {"a/b c": false}}`, x: `{"a/b c": 1}`, pass: false, message: "#/a~1b%%20c: expected no value, but was 1 (#/properties/a~1b%%20c)"},
+		{schema: `{"allOf": [{"minimum": 1}, {"maximum": 0}]}`, x: `2`, pass: false, message: "#: expected a number at most 0, but was 2 (#/allOf/1/maximum)"},
+		{schema: `{"anyOf": [{"type": "string"}, {"minimum": 5}]}`, x: `2`, pass: false, message: "#: expected a value matching at least one of 2 schemas, but it matched none (#/anyOf)"},
+		{schema: `{"oneOf": [{"type": "integer"}, {"minimum": 0}]}`, x: `-1`, pass: true},
+		{schema: `{"oneOf": [{"type": "integer"}, {"minimum": 0}]}`, x: `1`, pass: false, message: "#: expected a value matching exactly one of 2 schemas, but it matched [0,1] (#/oneOf)"},
+		{schema: `{"not": {"type": "null"}}`, x: `null`, pass: false, message: "#: expected a value not matching the schema, but it matched (#/not)"},
+		{schema: `{"if": {"minimum": 10}, "then": {"multipleOf": 10}, "else": {"maximum": 5}}`, x: `15`, pass: false, message: "#: expected a multiple of 10, but was 15 (#/then/multipleOf)"},
+		{schema: `{"if": {"minimum": 10}, "then": {"multipleOf": 10}, "else": {"maximum": 5}}`, x: `7`, pass: false, message: "#: expected a number at most 5, but was 7 (#/else/maximum)"},
+		{schema: `{"$defs": {"node": {"type": "object", "properties": {"next": {"$ref": "#/$defs/node"}}}}, "$ref": "#/$defs/node"}`, x: `{"next": {"next": 1}}`, pass: false, message: "#/next/next: expected object, but was integer (#/$defs/node/type)"},
+		{schema: `{"$defs": {"id": {"$anchor": "id", "type": "integer"}}, "items": {"$ref": "#id"}}`, x: `[1, "2"]`, pass: false, message: "#/1: expected integer, but was string (#id/type)"},
+		{schema: `{"dependentRequired": {"card": ["billing", "cvc"]}}`, x: `{"card": 1, "cvc": 2}`, pass: false, message: "#: expected property \"billing\", as \"card\" was present, but it was missing (#/dependentRequired/card)"},
+		{schema: `{"dependentRequired": {"card": ["billing"]}}`, x: `{"billing": 1}`, pass: true},
+		{schema: `{"dependentSchemas": {"card": {"required": ["billing"]}}}`, x: `{"card": 1}`, pass: false, message: "#: expected property \"billing\", but it was missing (#/dependentSchemas/card/required)"},
+		{schema: `{"dependentSchemas": {"card": false}}`, x: `{"name": 1}`, pass: true},
+	}
+
+	for _, testCase := range testCases {
+		recorder := NewRecorder()
+		That(recorder, testCase.x).ConformsToJSONSchema(testCase.schema)
+
+		if !testCase.pass {
+			assertFailed(t, recorder)
+			assertFailureMessage(t, recorder, testCase.message)
+		} else {
+			assertPassed(t, recorder)
+		}
+	}
+}
+
+func TestConformsToJSONSchemaInvalidSchemas(t *testing.T) {
+	testCases := []struct {
+		schema  interface{}
+		message string
+	}{
+		{schema: `{"type": 5}`, message: "#/type: expected a string or array of strings, but was 5"},
+		{schema: `{"minProperties": -1}`, message: "#/minProperties: expected a non-negative integer, but was -1"},
+		{schema: `{"propertyNames": {"pattern": "("}}`, message: "#/propertyNames/pattern: invalid pattern \"(\""},
+		{schema: `{"$ref": "#/$defs/missing"}`, message: `#/$ref: reference "#/$defs/missing" could not be resolved`},
+		{schema: `{"$ref": "https://example.com/schema.json"}`, message: `#/$ref: unsupported reference "https://example.com/schema.json"`},
+		{schema: `{"$ref": "#"}`, message: `#/$ref: reference "#" is circular`},
+		{schema: `{"properties": {"items": 5}}`, message: "#/properties/items: expected a schema to be an object or boolean, but was 5"},
+		{schema: map[string]interface{}{"type": "string", "properties": []int{}}, message: "Expected a valid JSON schema, but it was not: #/properties: expected an object, but was []"},
+		{schema: `{`, message: "Expected a JSON schema, but it was not valid JSON: unexpected EOF\nschema: string"},
+		{schema: `{"unevaluatedProperties": false}`, message: `#/unevaluatedProperties: unsupported keyword "unevaluatedProperties"`},
+		{schema: `{"properties": {"items": {"unevaluatedItems": false}}}`, message: `#/properties/items/unevaluatedItems: unsupported keyword "unevaluatedItems"`},
+		{schema: `{"$dynamicRef": "#meta"}`, message: `#/$dynamicRef: unsupported keyword "$dynamicRef"`},
+		{schema: `{"$id": "https://example.com/root.json", "properties": {"items": {"$id": "items.json"}}}`, message: `#/properties/items/$id: unsupported keyword "$id" below the root of the schema`},
+		{schema: `{"$ref": "#missing"}`, message: `#/$ref: reference "#missing" could not be resolved`},
+		{schema: `{"$defs": {"a": {"$anchor": "x"}, "b": {"$anchor": "x"}}, "$ref": "#x"}`, message: `#/$ref: reference "#x" is ambiguous, as the anchor is defined 2 times`},
+		{schema: `{"dependentRequired": {"items": "a"}}`, message: "#/dependentRequired/items: expected an array, but was \"a\""},
+	}
+
+	for _, testCase := range testCases {
+		recorder := NewRecorder()
+		That(recorder, `{"items": [1]}`).Not().ConformsToJSONSchema(testCase.schema)
+
+		assertFailed(t, recorder)
+		assertFailureMessage(t, recorder, testCase.message)
+	}
+}
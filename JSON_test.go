@@ -0,0 +1,164 @@
+package test
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestIsValidJSON(t *testing.T) {
+	testCases := []struct {
+		x       interface{}
+		pass    bool
+		message string
+	}{
+		{x: `{"a": [1, 2]}`, pass: true},
+		{x: []byte(`"text"`), pass: true},
+		{x: json.RawMessage(`null`), pass: true},
+		{x: strings.NewReader(` 1.5e3 `), pass: true},
+		{x: `{"a": }`, pass: false, message: `Expected "{\"a\": }" to be valid JSON, but it was not: invalid character '}' looking for beginning of value`},
+		{x: `{} {}`, pass: false, message: "but it was not: unexpected data after top-level value"},
+		{x: ``, pass: false, message: "but it was not: unexpected EOF"},
+		{x: 5, pass: false, message: "Expected a string, []byte, json.RawMessage or io.Reader, but was not\nx: int"},
+	}
+
+	for _, testCase := range testCases {
+		recorder := NewRecorder()
+		That(recorder, testCase.x).IsValidJSON()
+
+		if !testCase.pass {
+			assertFailed(t, recorder)
+			assertHelperCount(t, recorder, 3)
+			assertFailureMessage(t, recorder, testCase.message)
+		} else {
+			assertPassed(t, recorder)
+			assertHelperCount(t, recorder, 2)
+		}
+	}
+}
+
+func TestEqualsJSON(t *testing.T) {
+	type item struct {
+		ID   int    `json:"id"`
+		Name string `json:"name"`
+	}
+
+	testCases := []struct {
+		x       interface{}
+		y       interface{}
+		pass    bool
+		message string
+	}{
+		{x: `{"a": 1, "b": [true, null]}`, y: `{"b":[true,null],"a":1}`, pass: true},
+		{x: `{"n": 1}`, y: `{"n": 1.0}`, pass: true},
+		{x: `{"n": 100}`, y: `{"n": 1e2}`, pass: true},
+		{x: `{"n": 12345678901234567890}`, y: `{"n": 12345678901234567891}`, pass: false, message: "$.n: 12345678901234567890 != 12345678901234567891"},
+		{x: bytes.NewBufferString(`{"id": 1, "name": "a"}`), y: item{ID: 1, Name: "a"}, pass: true},
+		{x: `[{"id": 1, "name": "a"}]`, y: []item{{ID: 2, Name: "a"}, {ID: 3}}, pass: false, message: "$[0].id: 1 != 2\n$[1]: <missing> != {\"id\":3,\"name\":\"\"}"},
+		{
+			x:       `{"a": 1, "c": {"d": "x"}, "e f": 1}`,
+			y:       `{"b": 2, "c": {"d": "y"}, "e f": "1"}`,
+			pass:    false,
+			message: "Expected {\"a\":1,\"c\":{\"d\":\"x\"},\"e f\":1} to equal JSON {\"b\":2,\"c\":{\"d\":\"y\"},\"e f\":\"1\"}\n\n$.a: 1 != <missing>\n$.b: <missing> != 2\n$.c.d: \"x\" != \"y\"\n$[\"e f\"]: 1 != \"1\"",
+		},
+		{x: `{"a": 1}`, y: `{"a": `, pass: false, message: "Expected comparator to be valid JSON, but it was not: unexpected EOF\ny: string"},
+		{x: `{"a": `, y: `{"a": 1}`, pass: false, message: `Expected "{\"a\": " to be valid JSON, but it was not: unexpected EOF`},
+	}
+
+	for _, testCase := range testCases {
+		recorder := NewRecorder()
+		That(recorder, testCase.x).EqualsJSON(testCase.y)
+
+		if !testCase.pass {
+			assertFailed(t, recorder)
+			assertHelperCount(t, recorder, 3)
+			assertFailureMessage(t, recorder, testCase.message)
+		} else {
+			assertPassed(t, recorder)
+			assertHelperCount(t, recorder, 2)
+		}
+	}
+}
+
+func TestHasJSONPath(t *testing.T) {
+	doc := `{"items": [{"id": 7, "tags": {"a b": null}}], "total": 1}`
+
+	testCases := []struct {
+		path    string
+		pass    bool
+		message string
+	}{
+		{path: "$", pass: true},
+		{path: "$.items[0].id", pass: true},
+		{path: `$.items[0].tags["a b"]`, pass: true},
+		{path: `$['items'][0]`, pass: true},
+		{path: "$.items[1].id", pass: false, message: "to have JSON path $.items[1].id, but $.items[1] did not exist"},
+		{path: "$.total.value", pass: false, message: "to have JSON path $.total.value, but $.total.value did not exist"},
+		{path: "$.missing", pass: false, message: "to have JSON path $.missing, but $.missing did not exist"},
+		{path: "items", pass: false, message: `Expected a valid JSON path, but "items" was not: it must start with $`},
+		{path: "$.items[first]", pass: false, message: "expected a quoted member name or non-negative index in [first]"},
+		{path: "$.items[0", pass: false, message: `expected ] to close "[0"`},
+		{path: "$..items", pass: false, message: `expected a member name at ".`},
+	}
+
+	for _, testCase := range testCases {
+		recorder := NewRecorder()
+		That(recorder, doc).HasJSONPath(testCase.path)
+
+		if !testCase.pass {
+			assertFailed(t, recorder)
+			assertHelperCount(t, recorder, 3)
+			assertFailureMessage(t, recorder, testCase.message)
+		} else {
+			assertPassed(t, recorder)
+			assertHelperCount(t, recorder, 2)
+		}
+	}
+}
+
+func TestJSONPathEquals(t *testing.T) {
+	doc := `{"items": [{"id": 7, "name": "widget", "dims": {"w": 2, "h": 3}}]}`
+
+	testCases := []struct {
+		path    string
+		v       interface{}
+		pass    bool
+		message string
+	}{
+		{path: "$.items[0].id", v: 7, pass: true},
+		{path: "$.items[0].id", v: 7.0, pass: true},
+		{path: "$.items[0].name", v: "widget", pass: true},
+		{path: "$.items[0].dims", v: map[string]int{"h": 3, "w": 2}, pass: true},
+		{path: "$.items[0].dims", v: json.RawMessage(`{"w": 2, "h": 3}`), pass: true},
+		{path: "$.items[0].name", v: "gadget", pass: false, message: "Expected JSON path $.items[0].name of " + `{"items":[{"id":7,"name":"widget","dims":{"w":2,"h":3}}]}` + ` to equal "gadget"` + "\n\n" + `$.items[0].name: "widget" != "gadget"`},
+		{path: "$.items[0].dims", v: map[string]int{"w": 2, "h": 4}, pass: false, message: "$.items[0].dims.h: 3 != 4"},
+		{path: "$.items[2]", v: nil, pass: false, message: "to have JSON path $.items[2] equal to null, but $.items[2] did not exist"},
+	}
+
+	for _, testCase := range testCases {
+		recorder := NewRecorder()
+		That(recorder, doc).JSONPathEquals(testCase.path, testCase.v)
+
+		if !testCase.pass {
+			assertFailed(t, recorder)
+			assertHelperCount(t, recorder, 3)
+			assertFailureMessage(t, recorder, testCase.message)
+		} else {
+			assertPassed(t, recorder)
+			assertHelperCount(t, recorder, 2)
+		}
+	}
+}
+
+func TestNotEqualsJSON(t *testing.T) {
+	// Arrange.
+	recorder := NewRecorder()
+
+	// Act.
+	That(recorder, `{"a": 1}`).Not().EqualsJSON(`{"a": 1.0}`)
+
+	// Assert.
+	assertFailed(t, recorder)
+	assertFailureMessage(t, recorder, `Expected {"a":1} to not equal JSON {"a":1.0}`)
+}
@@ -0,0 +1,224 @@
+package test
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// HasKey fails the test if the subject, x, is not a map with a key deeply
+// equal to k.
+func (a *Assertions) HasKey(k interface{}) {
+	a.t.Helper()
+
+	if m, failed := a.evaluate(hasKey(k)); failed {
+		formattedFailure(a, m.format, m.args...)
+	}
+}
+
+// DoesNotHaveKey fails the test if the subject, x, is not a map, or has a key
+// deeply equal to k.
+func (a *Assertions) DoesNotHaveKey(k interface{}) {
+	a.t.Helper()
+
+	if m, failed := a.Not().evaluate(hasKey(k)); failed {
+		formattedFailure(a, m.format, m.args...)
+	}
+}
+
+// HasKeys fails the test if the subject, x, is not a map with a key deeply
+// equal to each of ks.
+func (a *Assertions) HasKeys(ks ...interface{}) {
+	a.t.Helper()
+
+	if m, failed := a.evaluate(hasKeys(ks)); failed {
+		formattedFailure(a, m.format, m.args...)
+	}
+}
+
+// HasEntry fails the test if the subject, x, is not a map with a key deeply
+// equal to k whose value is deeply equal to v.
+func (a *Assertions) HasEntry(k interface{}, v interface{}) {
+	a.t.Helper()
+
+	if m, failed := a.evaluate(hasEntry(k, v)); failed {
+		formattedFailure(a, m.format, m.args...)
+	}
+}
+
+// IsSubsetOf fails the test if the subject, x, and m are not both maps, or if
+// any entry of x is missing from m or has a value in m that is not deeply
+// equal.
+func (a *Assertions) IsSubsetOf(m interface{}) {
+	a.t.Helper()
+
+	if msg, failed := a.evaluate(subsetOf(m)); failed {
+		formattedFailure(a, msg.format, msg.args...)
+	}
+}
+
+// IsSupersetOf fails the test if the subject, x, and m are not both maps, or if
+// any entry of m is missing from x or has a value in x that is not deeply
+// equal.
+func (a *Assertions) IsSupersetOf(m interface{}) {
+	a.t.Helper()
+
+	if msg, failed := a.evaluate(supersetOf(m)); failed {
+		formattedFailure(a, msg.format, msg.args...)
+	}
+}
+
+func hasKey(k interface{}) predicate {
+	return withMap(func(x interface{}, xv reflect.Value) outcome {
+		if _, found := baseMapLookup(xv, k); !found {
+			return failed("Expected %v to have key %v, but it did not", x, k)
+		}
+
+		return passed("Expected %v to not have key %v, but it did", x, k)
+	})
+}
+
+func hasKeys(ks []interface{}) predicate {
+	return withMap(func(x interface{}, xv reflect.Value) outcome {
+		var missing []interface{}
+		for _, k := range ks {
+			if _, found := baseMapLookup(xv, k); !found {
+				missing = append(missing, k)
+			}
+		}
+
+		if len(missing) > 0 {
+			return failed("Expected %v to have keys %v, but it was missing %v", x, ks, missing)
+		}
+
+		return passed("Expected %v to not have all of the keys %v, but it did", x, ks)
+	})
+}
+
+func hasEntry(k interface{}, v interface{}) predicate {
+	return withMap(func(x interface{}, xv reflect.Value) outcome {
+		value, found := baseMapLookup(xv, k)
+		if !found {
+			return failed("Expected %v to have entry %v: %v, but it had no key %v", x, k, v, k)
+		}
+
+		d := &differ{visited: make(map[visit]bool)}
+		d.compare(fmt.Sprintf("[%v]", formatMapKey(reflect.ValueOf(k))), value, reflect.ValueOf(v))
+
+		if len(d.diffs) > 0 {
+			return failed("Expected %v to have entry %v: %v, but its value was %v\n\n%s", x, k, v, value, strings.Join(d.diffs, "\n"))
+		}
+
+		return passed("Expected %v to not have entry %v: %v, but it did", x, k, v)
+	})
+}
+
+func subsetOf(m interface{}) predicate {
+	return withMaps(m, func(x interface{}, xv reflect.Value, mv reflect.Value) outcome {
+		if report := baseMapSubsetReport(xv, mv); report != "" {
+			return failed("Expected %v to be a subset of %v\n%s", x, m, report)
+		}
+
+		return passed("Expected %v to not be a subset of %v, but it was", x, m)
+	})
+}
+
+func supersetOf(m interface{}) predicate {
+	return withMaps(m, func(x interface{}, xv reflect.Value, mv reflect.Value) outcome {
+		if report := baseMapSubsetReport(mv, xv); report != "" {
+			return failed("Expected %v to be a superset of %v\n%s", x, m, report)
+		}
+
+		return passed("Expected %v to not be a superset of %v, but it was", x, m)
+	})
+}
+
+// withMap returns a predicate that applies p to the subject as a map.  The
+// outcome is invalid if the subject is not a map.
+func withMap(p func(x interface{}, xv reflect.Value) outcome) predicate {
+	return func(x interface{}) outcome {
+		xv := reflect.ValueOf(x)
+		if xv.Kind() != reflect.Map {
+			return invalid("Expected subject to be a map, but was not\nx: %s", typeNameFor(x))
+		}
+
+		return p(x, xv)
+	}
+}
+
+// withMaps is like withMap, but also requires the comparator, m, to be a map.
+func withMaps(m interface{}, p func(x interface{}, xv reflect.Value, mv reflect.Value) outcome) predicate {
+	return withMap(func(x interface{}, xv reflect.Value) outcome {
+		mv := reflect.ValueOf(m)
+		if mv.Kind() != reflect.Map {
+			return invalid("Expected comparator to be a map, but was not\ny: %s", typeNameFor(m))
+		}
+
+		return p(x, xv, mv)
+	})
+}
+
+// baseMapLookup returns the value stored in the map mv under the key deeply
+// equal to k, as for baseMapIndex.
+func baseMapLookup(mv reflect.Value, k interface{}) (reflect.Value, bool) {
+	kv := reflect.ValueOf(k)
+	if kv.IsValid() && kv.Type().AssignableTo(mv.Type().Key()) && kv.Type().Comparable() {
+		if !mv.MapIndex(kv).IsValid() {
+			return reflect.Value{}, false
+		}
+
+		return baseMapIndex(mv, kv), true
+	}
+
+	for _, key := range mv.MapKeys() {
+		if len(deepDiff(key.Interface(), k)) == 0 {
+			return baseMapIndex(mv, key), true
+		}
+	}
+
+	return reflect.Value{}, false
+}
+
+// baseMapIndex returns the value stored in the map mv under key.  Values of
+// interface type are unwrapped, so that the value in a map[string]interface{}
+// can be compared with the value in a map[string]string or with a plain value.
+func baseMapIndex(mv reflect.Value, key reflect.Value) reflect.Value {
+	value := mv.MapIndex(key)
+	if value.Kind() == reflect.Interface {
+		return value.Elem()
+	}
+
+	return value
+}
+
+// baseMapSubsetReport describes every entry of sub that is missing from super
+// or has a different value in super.  An empty result means sub is a subset of
+// super.
+func baseMapSubsetReport(sub reflect.Value, super reflect.Value) string {
+	keys := sub.MapKeys()
+	sortValues(keys)
+
+	var missing []interface{}
+	d := &differ{visited: make(map[visit]bool)}
+
+	for _, key := range keys {
+		value, found := baseMapLookup(super, key.Interface())
+		if !found {
+			missing = append(missing, key.Interface())
+			continue
+		}
+
+		d.compare(fmt.Sprintf("[%v]", formatMapKey(key)), baseMapIndex(sub, key), value)
+	}
+
+	sb := &strings.Builder{}
+	if len(missing) > 0 {
+		fmt.Fprintf(sb, "\nmissing keys: %v", missing)
+	}
+
+	if len(d.diffs) > 0 {
+		fmt.Fprintf(sb, "\ndiffering values:\n  %v", strings.Join(d.diffs, "\n  "))
+	}
+
+	return sb.String()
+}
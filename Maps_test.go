@@ -0,0 +1,193 @@
+package test
+
+import "testing"
+
+func TestHasKey(t *testing.T) {
+	testCases := []struct {
+		x   interface{}
+		k   interface{}
+		has bool
+		ok  bool
+	}{
+		{x: map[string]int{"a": 1}, k: "a", has: true, ok: true},
+		{x: map[string]int{"a": 1}, k: "b", has: false, ok: true},
+		{x: map[string]int{"a": 1}, k: 1, has: false, ok: true},
+		{x: map[interface{}]int{"a": 1, 2: 2}, k: 2, has: true, ok: true},
+		{x: map[[2]int]bool{{1, 2}: true}, k: [2]int{1, 2}, has: true, ok: true},
+		{x: []string{"a"}, k: "a", ok: false},
+	}
+
+	for _, testCase := range testCases {
+		hasKey := NewRecorder()
+		doesNotHaveKey := NewRecorder()
+
+		That(hasKey, testCase.x).HasKey(testCase.k)
+		That(doesNotHaveKey, testCase.x).DoesNotHaveKey(testCase.k)
+
+		switch {
+		case !testCase.ok:
+			assertFailed(t, hasKey)
+			assertFailed(t, doesNotHaveKey)
+			assertFailureMessage(t, hasKey, "Expected subject to be a map, but was not")
+		case testCase.has:
+			assertPassed(t, hasKey)
+			assertFailed(t, doesNotHaveKey)
+			assertFailureMessage(t, doesNotHaveKey, "Expected %v to not have key %v, but it did", testCase.x, testCase.k)
+		default:
+			assertFailed(t, hasKey)
+			assertPassed(t, doesNotHaveKey)
+			assertFailureMessage(t, hasKey, "Expected %v to have key %v, but it did not", testCase.x, testCase.k)
+		}
+	}
+}
+
+func TestHasKeys(t *testing.T) {
+	// Arrange.
+	x := map[string]string{"Content-Type": "text/plain", "Accept": "*/*"}
+
+	// Act.
+	pass := NewRecorder()
+	fail := NewRecorder()
+
+	That(pass, x).HasKeys("Accept", "Content-Type")
+	That(fail, x).HasKeys("Accept", "Authorization", "Host")
+
+	// Assert.
+	assertPassed(t, pass)
+	assertHelperCount(t, pass, 2)
+
+	assertFailed(t, fail)
+	assertFailureMessage(t, fail, "but it was missing []interface{}{\"Authorization\", \"Host\"}")
+}
+
+func TestHasEntry(t *testing.T) {
+	type endpoint struct {
+		Host string
+		Port int
+	}
+
+	x := map[string]endpoint{"api": {Host: "localhost", Port: 80}}
+
+	testCases := []struct {
+		k       interface{}
+		v       interface{}
+		pass    bool
+		message string
+	}{
+		{k: "api", v: endpoint{Host: "localhost", Port: 80}, pass: true},
+		{k: "api", v: endpoint{Host: "localhost", Port: 81}, pass: false, message: "but its value was test.endpoint{Host: \"localhost\", Port: 80}\n\n[\"api\"].Port: 80 != 81"},
+		{k: "web", v: endpoint{}, pass: false, message: "but it had no key \"web\""},
+	}
+
+	for _, testCase := range testCases {
+		recorder := NewRecorder()
+		That(recorder, x).HasEntry(testCase.k, testCase.v)
+
+		if !testCase.pass {
+			assertFailed(t, recorder)
+			assertFailureMessage(t, recorder, testCase.message)
+		} else {
+			assertPassed(t, recorder)
+		}
+	}
+}
+
+func TestHasEntryWithInterfaceValues(t *testing.T) {
+	x := map[string]interface{}{"a": "b", "n": 1, "nil": nil, "list": []int{1, 2}}
+
+	testCases := []struct {
+		k       interface{}
+		v       interface{}
+		pass    bool
+		message string
+	}{
+		{k: "a", v: "b", pass: true},
+		{k: "n", v: 1, pass: true},
+		{k: "nil", v: nil, pass: true},
+		{k: "list", v: []int{1, 2}, pass: true},
+		{k: "a", v: "c", pass: false, message: "[\"a\"]: \"b\" != \"c\""},
+		{k: "n", v: int64(1), pass: false, message: "[\"n\"]: type int != type int64"},
+		{k: "nil", v: "b", pass: false, message: "[\"nil\"]: nil != \"b\""},
+		{k: "list", v: []int{1, 3}, pass: false, message: "[\"list\"][1]: 2 != 3"},
+	}
+
+	for _, testCase := range testCases {
+		recorder := NewRecorder()
+		That(recorder, x).HasEntry(testCase.k, testCase.v)
+
+		if !testCase.pass {
+			assertFailed(t, recorder)
+			assertFailureMessage(t, recorder, testCase.message)
+		} else {
+			assertPassed(t, recorder)
+		}
+	}
+}
+
+func TestIsSubsetOfAndIsSupersetOf(t *testing.T) {
+	testCases := []struct {
+		x        interface{}
+		m        interface{}
+		subset   bool
+		superset bool
+	}{
+		{x: map[string]int{"a": 1}, m: map[string]int{"a": 1, "b": 2}, subset: true, superset: false},
+		{x: map[string]int{"a": 1, "b": 2}, m: map[string]int{"a": 1}, subset: false, superset: true},
+		{x: map[string]int{"a": 1}, m: map[string]int{"a": 1}, subset: true, superset: true},
+		{x: map[string]int{"a": 1}, m: map[string]int{"a": 2}, subset: false, superset: false},
+		{x: map[string]int{}, m: map[string]int{"a": 2}, subset: true, superset: false},
+		{x: map[string]interface{}{"a": 1}, m: map[string]int{"a": 1, "b": 2}, subset: true, superset: false},
+		{x: map[string]int{"a": 1, "b": 2}, m: map[string]interface{}{"a": 1}, subset: false, superset: true},
+		{x: map[string]interface{}{"a": "b"}, m: map[string]interface{}{"a": "b"}, subset: true, superset: true},
+		{x: map[string]interface{}{"a": nil}, m: map[string]*int{"a": nil}, subset: false, superset: false},
+	}
+
+	for _, testCase := range testCases {
+		subset := NewRecorder()
+		superset := NewRecorder()
+
+		That(subset, testCase.x).IsSubsetOf(testCase.m)
+		That(superset, testCase.x).IsSupersetOf(testCase.m)
+
+		if testCase.subset {
+			assertPassed(t, subset)
+		} else {
+			assertFailed(t, subset)
+			assertFailureMessage(t, subset, "Expected %v to be a subset of %v", testCase.x, testCase.m)
+		}
+
+		if testCase.superset {
+			assertPassed(t, superset)
+		} else {
+			assertFailed(t, superset)
+			assertFailureMessage(t, superset, "Expected %v to be a superset of %v", testCase.x, testCase.m)
+		}
+	}
+}
+
+func TestIsSubsetOfReportsDifferences(t *testing.T) {
+	// Arrange.
+	x := map[string]interface{}{"host": "localhost", "port": 80, "tls": true}
+	m := map[string]interface{}{"host": "localhost", "port": 8080}
+
+	// Act.
+	recorder := NewRecorder()
+
+	That(recorder, x).IsSubsetOf(m)
+
+	// Assert.
+	assertFailed(t, recorder)
+	assertFailureMessage(t, recorder, "\nmissing keys: [tls]\ndiffering values:\n  [\"port\"]: 80 != 8080")
+}
+
+func TestIsSubsetOfExpectsMaps(t *testing.T) {
+	// Arrange.
+	recorder := NewRecorder()
+
+	// Act.
+	That(recorder, map[string]int{}).IsSubsetOf([]int{})
+
+	// Assert.
+	assertFailed(t, recorder)
+	assertFailureMessage(t, recorder, "Expected comparator to be a map, but was not\ny: []int")
+}
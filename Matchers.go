@@ -0,0 +1,324 @@
+package test
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// Matcher is a reusable, composable check that can be made about a value.
+// Match reports whether x satisfies the matcher, or returns an error if the
+// matcher cannot be applied to x at all, such as when x has the wrong type.
+// Describe and DescribeNegation complete the sentences "Expected x to ..." and
+// "Expected x to not ..." respectively, for example "be a valid UUID" and "not
+// be a valid UUID".
+type Matcher interface {
+	Match(x interface{}) (bool, error)
+	Describe() string
+	DescribeNegation() string
+}
+
+// Satisfies fails the test if the subject, x, does not satisfy m.  A matcher
+// that returns an error fails the test even if the assertion is negated.
+func (a *Assertions) Satisfies(m Matcher) {
+	a.t.Helper()
+
+	if msg, failed := a.evaluate(predicateFor(m)); failed {
+		formattedFailure(a, msg.format, msg.args...)
+	}
+}
+
+// AllOf returns a Matcher that is satisfied by values that satisfy every one
+// of ms.  The first matcher that is not satisfied is reported.
+func AllOf(ms ...Matcher) Matcher {
+	negation := describeAll(ms, Matcher.DescribeNegation, " or ")
+
+	return &predicateMatcher{
+		p: func(x interface{}) outcome {
+			for _, m := range ms {
+				o := predicateFor(m)(x)
+				if o.invalid || !o.pass {
+					return o
+				}
+			}
+
+			return passed("Expected %v to %s", x, negation)
+		},
+		description: describeAll(ms, Matcher.Describe, " and "),
+		negation:    negation,
+	}
+}
+
+// AnyOf returns a Matcher that is satisfied by values that satisfy at least
+// one of ms.  When negated, the first matcher that is satisfied is reported.
+// Matchers that cannot be applied to a value are not satisfied by it, and only
+// if none of ms can be applied is the first of them reported instead.
+func AnyOf(ms ...Matcher) Matcher {
+	description := describeAll(ms, Matcher.Describe, " or ")
+
+	return &predicateMatcher{
+		p: func(x interface{}) outcome {
+			var firstInvalid *outcome
+			applied := false
+
+			for _, m := range ms {
+				o := predicateFor(m)(x)
+				if o.pass {
+					return o
+				}
+
+				if !o.invalid {
+					applied = true
+				} else if firstInvalid == nil {
+					firstInvalid = &o
+				}
+			}
+
+			if !applied && firstInvalid != nil {
+				return *firstInvalid
+			}
+
+			return failed("Expected %v to %s", x, description)
+		},
+		description: description,
+		negation:    describeAll(ms, Matcher.DescribeNegation, " and "),
+	}
+}
+
+// Not returns a Matcher that is satisfied by values that do not satisfy m.  A
+// value that m cannot be applied to satisfies neither m nor Not(m).
+func Not(m Matcher) Matcher {
+	p := predicateFor(m)
+
+	return &predicateMatcher{
+		p: func(x interface{}) outcome {
+			o := p(x)
+			return outcome{pass: !o.pass, invalid: o.invalid, failure: o.negation, negation: o.failure}
+		},
+		description: m.DescribeNegation(),
+		negation:    m.Describe(),
+	}
+}
+
+// EqualTo returns a Matcher that is satisfied by values equal to y, as with
+// IsEqualTo.
+func EqualTo(y interface{}) Matcher {
+	return matcherFor(equalTo(y), "be equal to %v", y)
+}
+
+// DeeplyEqualTo returns a Matcher that is satisfied by values structurally
+// equal to y, as with IsDeeplyEqualTo.
+func DeeplyEqualTo(y interface{}) Matcher {
+	return matcherFor(deeplyEqualTo(y), "be deeply equal to %v", y)
+}
+
+// Nil returns a Matcher that is satisfied by nil values, as with IsNil.
+func Nil() Matcher {
+	return matcherFor(isNil(), "be nil")
+}
+
+// True returns a Matcher that is satisfied by the boolean true.
+func True() Matcher {
+	return matcherFor(isTrue(), "be true")
+}
+
+// False returns a Matcher that is satisfied by the boolean false.
+func False() Matcher {
+	return Not(True())
+}
+
+// GreaterThan returns a Matcher that is satisfied by numbers and times greater
+// than y, as with IsGreaterThan.
+func GreaterThan(y interface{}) Matcher {
+	return matcherFor(greaterThan(y), "be greater than %v", y)
+}
+
+// GreaterThanOrEqualTo returns a Matcher that is satisfied by numbers and
+// times greater than or equal to y, as with IsGreaterThanOrEqualTo.
+func GreaterThanOrEqualTo(y interface{}) Matcher {
+	return matcherFor(greaterThanOrEqualTo(y), "be greater than or equal to %v", y)
+}
+
+// LessThan returns a Matcher that is satisfied by numbers and times less than
+// y, as with IsLessThan.
+func LessThan(y interface{}) Matcher {
+	return matcherFor(lessThan(y), "be less than %v", y)
+}
+
+// LessThanOrEqualTo returns a Matcher that is satisfied by numbers and times
+// less than or equal to y, as with IsLessThanOrEqualTo.
+func LessThanOrEqualTo(y interface{}) Matcher {
+	return matcherFor(lessThanOrEqualTo(y), "be less than or equal to %v", y)
+}
+
+// Between returns a Matcher that is satisfied by numbers and times between lo
+// and hi inclusive, as with IsBetween.
+func Between(lo interface{}, hi interface{}) Matcher {
+	return matcherFor(between(lo, hi), "be between %v and %v inclusive", lo, hi)
+}
+
+// Positive returns a Matcher that is satisfied by numbers greater than zero.
+func Positive() Matcher {
+	return matcherFor(isPositive(), "be positive")
+}
+
+// Negative returns a Matcher that is satisfied by numbers less than zero.
+func Negative() Matcher {
+	return matcherFor(isNegative(), "be negative")
+}
+
+// Zero returns a Matcher that is satisfied by the zero value of any type, as
+// with IsZero.
+func Zero() Matcher {
+	return matcherFor(isZero(), "be the zero value of its type")
+}
+
+// Even returns a Matcher that is satisfied by even integers.
+func Even() Matcher {
+	return matcherFor(isEven(), "be even")
+}
+
+// Odd returns a Matcher that is satisfied by odd integers.
+func Odd() Matcher {
+	return matcherFor(isOdd(), "be odd")
+}
+
+// MultipleOf returns a Matcher that is satisfied by exact integer multiples of
+// y, as with IsMultipleOf.
+func MultipleOf(y interface{}) Matcher {
+	return matcherFor(multipleOf(y), "be a multiple of %v", y)
+}
+
+// CloseTo returns a Matcher that is satisfied by numbers within delta of y, as
+// with IsCloseTo.
+func CloseTo(y float64, delta float64) Matcher {
+	return matcherFor(closeTo(y, delta), "be within %v of %v", delta, y)
+}
+
+// Contains returns a Matcher that is satisfied by slices, arrays, strings and
+// maps that contain y, as with the Contains assertion.
+func Contains(y interface{}) Matcher {
+	return matcherFor(contains(y), "contain %v", y)
+}
+
+// Empty returns a Matcher that is satisfied by values with a length of zero.
+func Empty() Matcher {
+	return matcherFor(isEmpty(), "be empty")
+}
+
+// HasLength returns a Matcher that is satisfied by values with a length of n.
+func HasLength(n int) Matcher {
+	return matcherFor(hasLength(n), "have length %v", n)
+}
+
+// HasKey returns a Matcher that is satisfied by maps that have the key k.
+func HasKey(k interface{}) Matcher {
+	return matcherFor(hasKey(k), "have key %v", k)
+}
+
+// StartsWith returns a Matcher that is satisfied by strings that start with
+// prefix, as with the StartsWith assertion.
+func StartsWith(prefix string) Matcher {
+	return matcherFor(startsWith(prefix), "start with %q", prefix)
+}
+
+// EndsWith returns a Matcher that is satisfied by strings that end with
+// suffix, as with the EndsWith assertion.
+func EndsWith(suffix string) Matcher {
+	return matcherFor(endsWith(suffix), "end with %q", suffix)
+}
+
+// ContainsSubstring returns a Matcher that is satisfied by strings that
+// contain substr.
+func ContainsSubstring(substr string) Matcher {
+	return matcherFor(containsSubstring(substr), "contain %q", substr)
+}
+
+// MatchesRegexp returns a Matcher that is satisfied by strings that match the
+// regular expression pattern.
+func MatchesRegexp(pattern string) Matcher {
+	return matcherFor(matchesRegexp(pattern), "match regular expression %q", pattern)
+}
+
+// Before returns a Matcher that is satisfied by times before y.
+func Before(y time.Time) Matcher {
+	return matcherFor(before(y), "be before %v", formatTime(y))
+}
+
+// After returns a Matcher that is satisfied by times after y.
+func After(y time.Time) Matcher {
+	return matcherFor(after(y), "be after %v", formatTime(y))
+}
+
+// Wraps returns a Matcher that is satisfied by errors whose chain contains
+// target, as determined by errors.Is.
+func Wraps(target error) Matcher {
+	return matcherFor(wrapsError(target), "wrap %v", target)
+}
+
+// predicateMatcher is a Matcher backed by a predicate, so that the detailed
+// failure messages of the built-in assertions are kept when they are used
+// through Satisfies.
+type predicateMatcher struct {
+	p           predicate
+	description string
+	negation    string
+}
+
+var _ Matcher = &predicateMatcher{}
+
+func matcherFor(p predicate, format string, args ...interface{}) Matcher {
+	description := sprintf(format, args...)
+
+	return &predicateMatcher{
+		p:           p,
+		description: description,
+		negation:    "not " + description,
+	}
+}
+
+func (m *predicateMatcher) Match(x interface{}) (bool, error) {
+	o := m.p(x)
+	if o.invalid {
+		return false, errors.New(o.failure.String())
+	}
+
+	return o.pass, nil
+}
+
+func (m *predicateMatcher) Describe() string {
+	return m.description
+}
+
+func (m *predicateMatcher) DescribeNegation() string {
+	return m.negation
+}
+
+// predicateFor returns a predicate that tests a subject against m.
+func predicateFor(m Matcher) predicate {
+	if pm, ok := m.(*predicateMatcher); ok {
+		return pm.p
+	}
+
+	return func(x interface{}) outcome {
+		ok, err := m.Match(x)
+		if err != nil {
+			return invalid("Expected %v to %s, but it could not be matched: %s", x, m.Describe(), err)
+		}
+
+		if !ok {
+			return failed("Expected %v to %s", x, m.Describe())
+		}
+
+		return passed("Expected %v to %s", x, m.DescribeNegation())
+	}
+}
+
+func describeAll(ms []Matcher, describe func(m Matcher) string, separator string) string {
+	descriptions := make([]string, len(ms))
+	for i, m := range ms {
+		descriptions[i] = describe(m)
+	}
+
+	return strings.Join(descriptions, separator)
+}
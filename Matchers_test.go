@@ -0,0 +1,204 @@
+package test
+
+import (
+	"errors"
+	"regexp"
+	"testing"
+)
+
+type uuidMatcher struct{}
+
+var uuidPattern = regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$`)
+
+func (uuidMatcher) Match(x interface{}) (bool, error) {
+	s, ok := x.(string)
+	if !ok {
+		return false, errors.New("not a string")
+	}
+
+	return uuidPattern.MatchString(s), nil
+}
+
+func (uuidMatcher) Describe() string {
+	return "be a valid UUID"
+}
+
+func (uuidMatcher) DescribeNegation() string {
+	return "not be a valid UUID"
+}
+
+func TestSatisfiesCustomMatcher(t *testing.T) {
+	testCases := []struct {
+		x       interface{}
+		pass    bool
+		message string
+	}{
+		{x: "123e4567-e89b-12d3-a456-426614174000", pass: true},
+		{x: "123e4567", pass: false, message: "Expected \"123e4567\" to be a valid UUID"},
+		{x: 5, pass: false, message: "Expected 5 to be a valid UUID, but it could not be matched: not a string"},
+	}
+
+	for _, testCase := range testCases {
+		recorder := NewRecorder()
+		That(recorder, testCase.x).Satisfies(uuidMatcher{})
+
+		if testCase.pass {
+			assertPassed(t, recorder)
+			assertHelperCount(t, recorder, 2)
+		} else {
+			assertFailed(t, recorder)
+			assertHelperCount(t, recorder, 3)
+			assertFailureMessage(t, recorder, testCase.message)
+		}
+	}
+}
+
+func TestSatisfiesNegatedCustomMatcher(t *testing.T) {
+	// Arrange.
+	recorder := NewRecorder()
+
+	// Act.
+	That(recorder, "123e4567-e89b-12d3-a456-426614174000").Not().Satisfies(uuidMatcher{})
+
+	// Assert.
+	assertFailed(t, recorder)
+	assertFailureMessage(t, recorder, "Expected \"123e4567-e89b-12d3-a456-426614174000\" to not be a valid UUID")
+}
+
+func TestSatisfiesKeepsBuiltInMessages(t *testing.T) {
+	// Arrange.
+	recorder := NewRecorder()
+
+	// Act.
+	That(recorder, "hello world").Satisfies(StartsWith("help"))
+
+	// Assert.
+	assertFailed(t, recorder)
+	assertFailureMessage(t, recorder, "Expected \"hello world\" to start with \"help\"\n\nx: \"hello world\"\ny: \"help\"\n       ^")
+}
+
+func TestAllOf(t *testing.T) {
+	testCases := []struct {
+		x       interface{}
+		pass    bool
+		message string
+	}{
+		{x: 4, pass: true},
+		{x: -4, pass: false, message: "Expected -4 to be positive"},
+		{x: 3, pass: false, message: "Expected 3 to be even"},
+		{x: "4", pass: false, message: "Expected a number, but was not\nx: string"},
+	}
+
+	for _, testCase := range testCases {
+		recorder := NewRecorder()
+		That(recorder, testCase.x).Satisfies(AllOf(Positive(), Even()))
+
+		if testCase.pass {
+			assertPassed(t, recorder)
+		} else {
+			assertFailed(t, recorder)
+			assertFailureMessage(t, recorder, testCase.message)
+		}
+	}
+}
+
+func TestAnyOf(t *testing.T) {
+	testCases := []struct {
+		x       interface{}
+		pass    bool
+		message string
+	}{
+		{x: nil, pass: true},
+		{x: "abc", pass: true},
+		{x: "xyz", pass: false, message: "Expected \"xyz\" to be nil or start with \"a\""},
+		{x: 5, pass: false, message: "Expected 5 to be nil or start with \"a\""},
+	}
+
+	for _, testCase := range testCases {
+		recorder := NewRecorder()
+		That(recorder, testCase.x).Satisfies(AnyOf(Nil(), StartsWith("a")))
+
+		if testCase.pass {
+			assertPassed(t, recorder)
+		} else {
+			assertFailed(t, recorder)
+			assertFailureMessage(t, recorder, testCase.message)
+		}
+	}
+}
+
+func TestAnyOfWithInapplicableMatchers(t *testing.T) {
+	// Arrange.
+	negated := NewRecorder()
+	inapplicable := NewRecorder()
+
+	// Act.
+	That(negated, 5).Satisfies(Not(AnyOf(Nil(), StartsWith("a"))))
+	That(inapplicable, 5).Satisfies(AnyOf(StartsWith("a"), EndsWith("z")))
+
+	// Assert.
+	assertPassed(t, negated)
+
+	assertFailed(t, inapplicable)
+	assertFailureMessage(t, inapplicable, "Expected a string, []byte, error or fmt.Stringer, but was not\nx: int")
+}
+
+func TestNotMatcher(t *testing.T) {
+	// Arrange.
+	pass := NewRecorder()
+	fail := NewRecorder()
+	invalid := NewRecorder()
+
+	// Act.
+	That(pass, []int{1, 2}).Satisfies(Not(Contains(3)))
+	That(fail, []int{1, 2}).Satisfies(Not(Contains(2)))
+	That(invalid, 5).Satisfies(Not(Contains(2)))
+
+	// Assert.
+	assertPassed(t, pass)
+	assertFailed(t, fail)
+	assertFailureMessage(t, fail, "Expected []int{1, 2} to not contain 2, but it did")
+	assertFailed(t, invalid)
+}
+
+func TestMatcherDescriptions(t *testing.T) {
+	testCases := []struct {
+		matcher     Matcher
+		description string
+		negation    string
+	}{
+		{matcher: EqualTo(5), description: "be equal to 5", negation: "not be equal to 5"},
+		{matcher: Not(Empty()), description: "not be empty", negation: "be empty"},
+		{matcher: AllOf(Positive(), Even()), description: "be positive and be even", negation: "not be positive or not be even"},
+		{matcher: AnyOf(Nil(), HasLength(2)), description: "be nil or have length 2", negation: "not be nil and not have length 2"},
+	}
+
+	for _, testCase := range testCases {
+		if d := testCase.matcher.Describe(); d != testCase.description {
+			t.Fatalf("expected description to be '%v' but was '%v'", testCase.description, d)
+		}
+
+		if d := testCase.matcher.DescribeNegation(); d != testCase.negation {
+			t.Fatalf("expected negation to be '%v' but was '%v'", testCase.negation, d)
+		}
+	}
+}
+
+func TestBuiltInMatcherMatch(t *testing.T) {
+	// Arrange.
+	m := GreaterThan(3)
+
+	// Act.
+	ok1, err1 := m.Match(4)
+	ok2, err2 := m.Match(2)
+	_, err3 := m.Match("4")
+
+	// Assert.
+	if !ok1 || err1 != nil || ok2 || err2 != nil {
+		t.Fatalf("expected 4 to match and 2 to not match")
+	}
+
+	if err3 == nil || err3.Error() != "Expected two comparable types\nx: string\ny: int" {
+		t.Fatalf("expected an error for a non-comparable subject but got %v", err3)
+	}
+}
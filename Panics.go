@@ -0,0 +1,151 @@
+package test
+
+import (
+	"regexp"
+	"runtime/debug"
+	"strings"
+)
+
+// Panics fails the test if the subject, x, is not a func() or does not panic
+// when called.
+func (a *Assertions) Panics() {
+	a.t.Helper()
+
+	if m, failed := a.evaluate(panics()); failed {
+		formattedFailure(a, m.format, m.args...)
+	}
+}
+
+// DoesNotPanic fails the test if the subject, x, is not a func() or panics
+// when called.
+func (a *Assertions) DoesNotPanic() {
+	a.t.Helper()
+
+	if m, failed := a.Not().evaluate(panics()); failed {
+		formattedFailure(a, m.format, m.args...)
+	}
+}
+
+// PanicsWith fails the test if the subject, x, is not a func() or does not
+// panic with a value deeply equal to y when called.
+func (a *Assertions) PanicsWith(y interface{}) {
+	a.t.Helper()
+
+	if m, failed := a.evaluate(panicsWith(y)); failed {
+		formattedFailure(a, m.format, m.args...)
+	}
+}
+
+// PanicsWithErrorMatching fails the test if the subject, x, is not a func()
+// or does not panic with an error whose message matches the regular
+// expression pattern when called.
+func (a *Assertions) PanicsWithErrorMatching(pattern string) {
+	a.t.Helper()
+
+	if m, failed := a.evaluate(panicsWithErrorMatching(pattern)); failed {
+		formattedFailure(a, m.format, m.args...)
+	}
+}
+
+func panics() predicate {
+	return withPanic(func(p panicResult) outcome {
+		if !p.didPanic {
+			return failed("Expected function to panic, but it did not")
+		}
+
+		return passed("Expected function not to panic, but it panicked with %v\n\n%s", p.value, p.stack)
+	})
+}
+
+func panicsWith(y interface{}) predicate {
+	return withPanic(func(p panicResult) outcome {
+		if !p.didPanic {
+			return failed("Expected function to panic with %v, but it did not panic", y)
+		}
+
+		if len(deepDiff(p.value, y)) > 0 {
+			return failed("Expected function to panic with %v, but it panicked with %v\nrecovered: %s\ny: %s\n\n%s", y, p.value, typeNameFor(p.value), typeNameFor(y), p.stack)
+		}
+
+		return passed("Expected function to not panic with %v, but it did\n\n%s", y, p.stack)
+	})
+}
+
+func panicsWithErrorMatching(pattern string) predicate {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return func(x interface{}) outcome {
+			return invalid("Expected a valid regular expression, but %q was invalid: %s", pattern, err)
+		}
+	}
+
+	return withPanic(func(p panicResult) outcome {
+		if !p.didPanic {
+			return failed("Expected function to panic with an error matching %q, but it did not panic", pattern)
+		}
+
+		perr, isError := p.value.(error)
+		if !isError {
+			return failed("Expected function to panic with an error matching %q, but it panicked with a non-error %v\nrecovered: %s\n\n%s", pattern, p.value, typeNameFor(p.value), p.stack)
+		}
+
+		if !re.MatchString(perr.Error()) {
+			return failed("Expected function to panic with an error matching %q, but it panicked with %q\n\n%s", pattern, perr.Error(), p.stack)
+		}
+
+		return passed("Expected function to not panic with an error matching %q, but it panicked with %q\n\n%s", pattern, perr.Error(), p.stack)
+	})
+}
+
+// panicResult describes the outcome of calling a function that may panic.
+type panicResult struct {
+	didPanic bool
+	value    interface{}
+	stack    string
+}
+
+// withPanic returns a predicate that calls the subject and applies p to the
+// result.  The outcome is invalid if the subject is not a func().
+func withPanic(p func(r panicResult) outcome) predicate {
+	return func(x interface{}) outcome {
+		fn, ok := x.(func())
+		if !ok || fn == nil {
+			return invalid("Expected subject to be a non-nil func(), but was not\nx: %s", typeNameFor(x))
+		}
+
+		return p(basePanicTest(fn))
+	}
+}
+
+func basePanicTest(fn func()) (p panicResult) {
+	defer func() {
+		if p.didPanic {
+			p.value = recover()
+			p.stack = panicStackFor(debug.Stack())
+		}
+	}()
+
+	p.didPanic = true
+	fn()
+	p.didPanic = false
+
+	return p
+}
+
+// panicStackFor trims a stack trace captured while recovering so that it
+// begins at the frame that panicked.
+func panicStackFor(stack []byte) string {
+	s := string(stack)
+
+	i := strings.Index(s, "\npanic(")
+	if i < 0 {
+		return s
+	}
+
+	lines := strings.SplitN(s[i+1:], "\n", 3)
+	if len(lines) < 3 {
+		return s
+	}
+
+	return "panic stack:\n" + strings.TrimSpace(lines[2])
+}
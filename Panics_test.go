@@ -0,0 +1,104 @@
+package test
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestPanics(t *testing.T) {
+	testCases := []struct {
+		x       interface{}
+		pass    bool
+		message string
+	}{
+		{x: func() { panic("boom") }, pass: true},
+		{x: func() {}, pass: false, message: "Expected function to panic, but it did not"},
+		{x: 5, pass: false, message: "Expected subject to be a non-nil func(), but was not\nx: int"},
+		{x: (func())(nil), pass: false, message: "Expected subject to be a non-nil func(), but was not\nx: func()"},
+	}
+
+	for _, testCase := range testCases {
+		recorder := NewRecorder()
+		That(recorder, testCase.x).Panics()
+
+		if !testCase.pass {
+			assertFailed(t, recorder)
+			assertFailureMessage(t, recorder, testCase.message)
+		} else {
+			assertPassed(t, recorder)
+		}
+	}
+}
+
+func TestDoesNotPanic(t *testing.T) {
+	// Arrange.
+	recorder := NewRecorder()
+
+	// Act.
+	That(recorder, func() {}).DoesNotPanic()
+	assertPassed(t, recorder)
+
+	That(recorder, panickingFunction).DoesNotPanic()
+
+	// Assert.
+	assertFailed(t, recorder)
+	assertFailureMessage(t, recorder, "Expected function not to panic, but it panicked with \"boom\"\n\npanic stack:\ngithub.com/ljpx/test.panickingFunction()")
+}
+
+func TestPanicsWith(t *testing.T) {
+	testCases := []struct {
+		x       func()
+		y       interface{}
+		pass    bool
+		message string
+	}{
+		{x: func() { panic("boom") }, y: "boom", pass: true},
+		{x: func() { panic([]int{1, 2}) }, y: []int{1, 2}, pass: true},
+		{x: func() { panic("boom") }, y: "bang", pass: false, message: "Expected function to panic with \"bang\", but it panicked with \"boom\""},
+		{x: func() { panic(5) }, y: int64(5), pass: false, message: "Expected function to panic with int64(5), but it panicked with 5\nrecovered: int\ny: int64"},
+		{x: func() {}, y: "boom", pass: false, message: "Expected function to panic with \"boom\", but it did not panic"},
+	}
+
+	for _, testCase := range testCases {
+		recorder := NewRecorder()
+		That(recorder, testCase.x).PanicsWith(testCase.y)
+
+		if !testCase.pass {
+			assertFailed(t, recorder)
+			assertFailureMessage(t, recorder, testCase.message)
+		} else {
+			assertPassed(t, recorder)
+		}
+	}
+}
+
+func TestPanicsWithErrorMatching(t *testing.T) {
+	testCases := []struct {
+		x       func()
+		pattern string
+		pass    bool
+		message string
+	}{
+		{x: func() { panic(errors.New("invalid id 42")) }, pattern: `^invalid id \d+$`, pass: true},
+		{x: func() { panic(errors.New("invalid name")) }, pattern: `^invalid id \d+$`, pass: false, message: "but it panicked with \"invalid name\""},
+		{x: func() { panic("invalid id 42") }, pattern: `invalid`, pass: false, message: "but it panicked with a non-error \"invalid id 42\"\nrecovered: string"},
+		{x: func() {}, pattern: `invalid`, pass: false, message: "but it did not panic"},
+		{x: func() {}, pattern: `(`, pass: false, message: "Expected a valid regular expression, but \"(\" was invalid"},
+	}
+
+	for _, testCase := range testCases {
+		recorder := NewRecorder()
+		That(recorder, testCase.x).PanicsWithErrorMatching(testCase.pattern)
+
+		if !testCase.pass {
+			assertFailed(t, recorder)
+			assertFailureMessage(t, recorder, testCase.message)
+		} else {
+			assertPassed(t, recorder)
+		}
+	}
+}
+
+func panickingFunction() {
+	panic("boom")
+}
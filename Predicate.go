@@ -0,0 +1,64 @@
+package test
+
+// predicate tests a subject, x, and describes the outcome.
+type predicate func(x interface{}) outcome
+
+// outcome is the result of testing a predicate against a subject.  failure
+// explains why the subject did not satisfy the predicate, and is used when the
+// assertion is made normally.  negation explains why the subject did satisfy
+// the predicate, and is used when the assertion is negated with Not.  Only the
+// message relevant to pass needs to be set.  An invalid outcome means the
+// predicate could not be applied to the subject at all, such as when it has
+// the wrong type, and always fails.
+type outcome struct {
+	pass     bool
+	invalid  bool
+	failure  message
+	negation message
+}
+
+// message is a failure message template and its arguments.  It is only
+// formatted if the failure is reported.
+type message struct {
+	format string
+	args   []interface{}
+}
+
+func messagef(format string, args ...interface{}) message {
+	return message{format: format, args: args}
+}
+
+// String formats the message.
+func (m message) String() string {
+	return sprintf(m.format, m.args...)
+}
+
+// passed returns an outcome for a subject that satisfied a predicate.  The
+// message is reported if the assertion was negated.
+func passed(format string, args ...interface{}) outcome {
+	return outcome{pass: true, negation: messagef(format, args...)}
+}
+
+// failed returns an outcome for a subject that did not satisfy a predicate.
+// The message is reported unless the assertion was negated.
+func failed(format string, args ...interface{}) outcome {
+	return outcome{pass: false, failure: messagef(format, args...)}
+}
+
+// invalid returns an outcome for a subject to which a predicate could not be
+// applied.  The message is reported whether or not the assertion was negated.
+func invalid(format string, args ...interface{}) outcome {
+	m := messagef(format, args...)
+	return outcome{invalid: true, failure: m, negation: m}
+}
+
+// evaluate tests the subject against p, taking negation into account.  It
+// returns the message to report and true if the assertion failed.
+func (a *Assertions) evaluate(p predicate) (message, bool) {
+	o := p(a.x)
+	if a.negated {
+		return o.negation, o.pass || o.invalid
+	}
+
+	return o.failure, !o.pass || o.invalid
+}
@@ -0,0 +1,413 @@
+package test
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	// prettyMaxElements is the number of elements of a slice, array or map
+	// that are printed before the rest are elided.
+	prettyMaxElements = 32
+
+	// prettyMaxWidth is the length beyond which a composite value is printed
+	// over multiple lines, one element or field per line.
+	prettyMaxWidth = 80
+
+	prettyIndent = "  "
+)
+
+// sprintf formats according to a format specifier like fmt.Sprintf, except
+// that every argument formatted with a plain %v is printed with formatValue.
+// Arguments that are already formatted text should use %s instead.
+func sprintf(format string, args ...interface{}) string {
+	wrapped := make([]interface{}, len(args))
+	for i, arg := range args {
+		wrapped[i] = pretty{x: arg}
+	}
+
+	return fmt.Sprintf(format, wrapped...)
+}
+
+// pretty is a fmt.Formatter that prints its value with formatValue for the
+// %v verb, and as fmt would for any other verb.
+type pretty struct {
+	x interface{}
+}
+
+func (p pretty) Format(f fmt.State, verb rune) {
+	if verb == 'v' && !f.Flag('+') && !f.Flag('#') {
+		io.WriteString(f, formatValue(p.x))
+		return
+	}
+
+	fmt.Fprintf(f, directiveFor(f, verb), p.x)
+}
+
+// directiveFor rebuilds the formatting directive that produced f and verb.
+func directiveFor(f fmt.State, verb rune) string {
+	sb := &strings.Builder{}
+	sb.WriteByte('%')
+
+	for _, flag := range "+-# 0" {
+		if f.Flag(int(flag)) {
+			sb.WriteRune(flag)
+		}
+	}
+
+	if width, ok := f.Width(); ok {
+		sb.WriteString(strconv.Itoa(width))
+	}
+
+	if precision, ok := f.Precision(); ok {
+		sb.WriteByte('.')
+		sb.WriteString(strconv.Itoa(precision))
+	}
+
+	sb.WriteRune(verb)
+	return sb.String()
+}
+
+// formatValue prints x in a syntax resembling a Go composite literal.  Strings
+// are quoted, pointers are followed, structs include their field names, nil
+// values are distinguished from empty ones, and values whose type cannot be
+// inferred from context are annotated with it.  Long collections are
+// truncated, wide values are split over several indented lines, and cyclic
+// references are printed as <cycle>.
+func formatValue(x interface{}) string {
+	if v, ok := x.(reflect.Value); ok {
+		return formatReflectValue(v, true)
+	}
+
+	return formatReflectValue(reflect.ValueOf(x), true)
+}
+
+// formatReflectValue prints v like formatValue.  Unexported struct fields can
+// be printed this way.  If annotate is false, v is assumed to appear where
+// its type is known, such as in a diff between two values of the same type.
+func formatReflectValue(v reflect.Value, annotate bool) string {
+	p := &printer{visiting: make(map[uintptr]bool)}
+	if annotate {
+		return p.format(v, inInterface)
+	}
+
+	return p.format(v, inField)
+}
+
+// formatType prints t as it would appear in Go source.
+func formatType(t reflect.Type) string {
+	if t == nil {
+		return "nil"
+	}
+
+	return strings.ReplaceAll(t.String(), "interface {}", "interface{}")
+}
+
+type printer struct {
+	visiting map[uintptr]bool
+}
+
+// position describes where a value appears, which determines how much of its
+// type must be printed for it to be unambiguous.
+type position int
+
+const (
+	// inInterface is a value whose type cannot be inferred, such as one at
+	// the top level or inside an interface.  Scalars are annotated with their
+	// type unless it is the default type for their literal.
+	inInterface position = iota
+
+	// inField is a value whose type can be inferred, such as a struct field.
+	inField
+
+	// inElement is an element, key or value of a collection, whose type can
+	// be inferred and may be elided from composite literals as Go allows.
+	inElement
+)
+
+// format prints v as it should appear at the provided position.
+func (p *printer) format(v reflect.Value, at position) string {
+	if !v.IsValid() {
+		return "nil"
+	}
+
+	if s, ok := p.formatText(v, at); ok {
+		return s
+	}
+
+	switch v.Kind() {
+	case reflect.Interface:
+		if v.IsNil() {
+			return "nil"
+		}
+
+		return p.format(v.Elem(), inInterface)
+
+	case reflect.Bool:
+		return p.scalar(v, strconv.FormatBool(v.Bool()), at)
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return p.scalar(v, strconv.FormatInt(v.Int(), 10), at)
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return p.scalar(v, strconv.FormatUint(v.Uint(), 10), at)
+
+	case reflect.Float32:
+		return p.scalar(v, strconv.FormatFloat(v.Float(), 'g', -1, 32), at)
+
+	case reflect.Float64:
+		return p.scalar(v, strconv.FormatFloat(v.Float(), 'g', -1, 64), at)
+
+	case reflect.Complex64, reflect.Complex128:
+		return p.scalar(v, fmt.Sprintf("%v", v.Complex()), at)
+
+	case reflect.String:
+		return p.scalar(v, strconv.Quote(v.String()), at)
+
+	case reflect.Ptr:
+		if v.IsNil() {
+			return fmt.Sprintf("(%s)(nil)", formatType(v.Type()))
+		}
+
+		if p.visiting[v.Pointer()] {
+			return "<cycle>"
+		}
+
+		p.visiting[v.Pointer()] = true
+		defer delete(p.visiting, v.Pointer())
+
+		return "&" + p.format(v.Elem(), inInterface)
+
+	case reflect.Struct:
+		fields := make([]string, v.NumField())
+		for i := range fields {
+			fields[i] = fmt.Sprintf("%s: %s", v.Type().Field(i).Name, p.format(v.Field(i), inField))
+		}
+
+		return p.composite(p.header(v, at), fields, 0, false)
+
+	case reflect.Slice:
+		if v.IsNil() {
+			return fmt.Sprintf("%s(nil)", formatType(v.Type()))
+		}
+
+		if s, ok := printableBytes(v); ok {
+			typ := formatType(v.Type())
+			if v.Type() == reflect.TypeOf([]byte(nil)) {
+				typ = "[]byte"
+			}
+
+			return fmt.Sprintf("%s(%s)", typ, strconv.Quote(s))
+		}
+
+		if p.visiting[v.Pointer()] && v.Len() > 0 {
+			return "<cycle>"
+		}
+
+		p.visiting[v.Pointer()] = true
+		defer delete(p.visiting, v.Pointer())
+
+		return p.sequence(v, at)
+
+	case reflect.Array:
+		return p.sequence(v, at)
+
+	case reflect.Map:
+		if v.IsNil() {
+			return fmt.Sprintf("%s(nil)", formatType(v.Type()))
+		}
+
+		if p.visiting[v.Pointer()] {
+			return "<cycle>"
+		}
+
+		p.visiting[v.Pointer()] = true
+		defer delete(p.visiting, v.Pointer())
+
+		return p.mapping(v, at)
+
+	case reflect.Chan, reflect.Func, reflect.UnsafePointer:
+		if v.IsNil() {
+			return fmt.Sprintf("(%s)(nil)", formatType(v.Type()))
+		}
+
+		return fmt.Sprintf("(%s)(%#x)", formatType(v.Type()), v.Pointer())
+	}
+
+	return fmt.Sprintf("%v", v)
+}
+
+// formatText prints values that describe themselves with an Error or String
+// method using that description, so that types such as time.Duration and
+// *big.Int are not printed field by field.  Times are printed in RFC 3339
+// format.
+func (p *printer) formatText(v reflect.Value, at position) (s string, ok bool) {
+	if !v.CanInterface() || v.Kind() == reflect.Interface {
+		return "", false
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Map, reflect.Slice, reflect.Func, reflect.Chan:
+		if v.IsNil() {
+			return "", false
+		}
+	}
+
+	defer func() {
+		if recover() != nil {
+			s, ok = "", false
+		}
+	}()
+
+	switch x := v.Interface().(type) {
+	case time.Time:
+		s = formatTime(x)
+	case error:
+		s = strconv.Quote(x.Error())
+	case fmt.Stringer:
+		s = x.String()
+	default:
+		return "", false
+	}
+
+	if at != inInterface {
+		return s, true
+	}
+
+	return fmt.Sprintf("%s(%s)", formatType(v.Type()), s), true
+}
+
+// scalar annotates s with the type of v if required.
+func (p *printer) scalar(v reflect.Value, s string, at position) string {
+	if at != inInterface || isDefaultType(v.Type()) {
+		return s
+	}
+
+	return fmt.Sprintf("%s(%s)", formatType(v.Type()), s)
+}
+
+// header returns the type to print before the braces of a composite literal,
+// which is elided for the elements of a collection.
+func (p *printer) header(v reflect.Value, at position) string {
+	if at == inElement {
+		return ""
+	}
+
+	return formatType(v.Type())
+}
+
+func (p *printer) sequence(v reflect.Value, at position) string {
+	n := v.Len()
+	if n > prettyMaxElements {
+		n = prettyMaxElements
+	}
+
+	elements := make([]string, n)
+	for i := range elements {
+		elements[i] = p.format(v.Index(i), inElement)
+	}
+
+	return p.composite(p.header(v, at), elements, v.Len()-n, true)
+}
+
+func (p *printer) mapping(v reflect.Value, at position) string {
+	keys := v.MapKeys()
+	sortValues(keys)
+
+	n := len(keys)
+	if n > prettyMaxElements {
+		n = prettyMaxElements
+	}
+
+	entries := make([]string, n)
+	for i := range entries {
+		entries[i] = fmt.Sprintf("%s: %s", p.format(keys[i], inElement), p.format(v.MapIndex(keys[i]), inElement))
+	}
+
+	return p.composite(p.header(v, at), entries, len(keys)-n, false)
+}
+
+// composite prints a composite literal of the given type with the provided
+// elements, noting how many further elements were elided.  It is split over
+// multiple lines if it would otherwise be too wide, with one element per line
+// unless pack is true and every element fits on one line, in which case as
+// many elements as fit are printed on each line.
+func (p *printer) composite(typ string, elements []string, elided int, pack bool) string {
+	if elided > 0 {
+		elements = append(elements, fmt.Sprintf("... (%v more)", elided))
+	}
+
+	single := fmt.Sprintf("%s{%s}", typ, strings.Join(elements, ", "))
+	if len(single) <= prettyMaxWidth && !strings.Contains(single, "\n") {
+		return single
+	}
+
+	sb := &strings.Builder{}
+	sb.WriteString(typ)
+	sb.WriteString("{\n")
+
+	if pack && !strings.Contains(strings.Join(elements, ""), "\n") {
+		line := prettyIndent
+		for _, element := range elements {
+			if line != prettyIndent && len(line)+len(element)+1 > prettyMaxWidth {
+				sb.WriteString(strings.TrimSuffix(line, " "))
+				sb.WriteString("\n")
+				line = prettyIndent
+			}
+
+			line += element + ", "
+		}
+
+		sb.WriteString(strings.TrimSuffix(line, " "))
+		sb.WriteString("\n}")
+		return sb.String()
+	}
+
+	for _, element := range elements {
+		sb.WriteString(prettyIndent)
+		sb.WriteString(strings.ReplaceAll(element, "\n", "\n"+prettyIndent))
+		sb.WriteString(",\n")
+	}
+
+	sb.WriteString("}")
+	return sb.String()
+}
+
+// isDefaultType reports whether t is the type that an untyped constant of
+// its kind defaults to, so that it need not be annotated.
+func isDefaultType(t reflect.Type) bool {
+	switch t {
+	case reflect.TypeOf(false), reflect.TypeOf(0), reflect.TypeOf(0.0), reflect.TypeOf(""), reflect.TypeOf(0i):
+		return true
+	}
+
+	return false
+}
+
+// printableBytes returns the contents of a []byte as a string if it is valid,
+// printable UTF-8.
+func printableBytes(v reflect.Value) (string, bool) {
+	if v.Type().Elem().Kind() != reflect.Uint8 {
+		return "", false
+	}
+
+	b := v.Bytes()
+	if !utf8.Valid(b) {
+		return "", false
+	}
+
+	s := string(b)
+	for _, r := range s {
+		if !unicode.IsPrint(r) && !unicode.IsSpace(r) {
+			return "", false
+		}
+	}
+
+	return s, true
+}
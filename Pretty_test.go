@@ -0,0 +1,138 @@
+package test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFormatValue(t *testing.T) {
+	type point struct {
+		X int
+		Y int
+	}
+
+	type node struct {
+		Value int
+		Next  *node
+	}
+
+	type celsius float64
+
+	cyclic := &node{Value: 1}
+	cyclic.Next = cyclic
+
+	testCases := []struct {
+		x        interface{}
+		expected string
+	}{
+		{x: nil, expected: "nil"},
+		{x: 4, expected: "4"},
+		{x: int64(4), expected: "int64(4)"},
+		{x: 2.5, expected: "2.5"},
+		{x: celsius(30), expected: "test.celsius(30)"},
+		{x: true, expected: "true"},
+		{x: "Hello", expected: `"Hello"`},
+		{x: "line\nbreak", expected: `"line\nbreak"`},
+		{x: []int(nil), expected: "[]int(nil)"},
+		{x: []int{}, expected: "[]int{}"},
+		{x: map[string]int(nil), expected: "map[string]int(nil)"},
+		{x: map[string]int{"b": 2, "a": 1}, expected: `map[string]int{"a": 1, "b": 2}`},
+		{x: [2]bool{true, false}, expected: "[2]bool{true, false}"},
+		{x: []interface{}{1, int8(2), "3"}, expected: `[]interface{}{1, int8(2), "3"}`},
+		{x: point{X: 1, Y: 2}, expected: "test.point{X: 1, Y: 2}"},
+		{x: []point{{X: 1, Y: 2}}, expected: "[]test.point{{X: 1, Y: 2}}"},
+		{x: &point{X: 1, Y: 2}, expected: "&test.point{X: 1, Y: 2}"},
+		{x: (*point)(nil), expected: "(*test.point)(nil)"},
+		{x: cyclic, expected: "&test.node{Value: 1, Next: <cycle>}"},
+		{x: []byte("hello"), expected: `[]byte("hello")`},
+		{x: []byte{0, 1}, expected: "[]uint8{0, 1}"},
+		{x: errors.New("boom"), expected: `*errors.errorString("boom")`},
+		{x: 90 * time.Second, expected: "time.Duration(1m30s)"},
+		{x: time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC), expected: "time.Time(2020-01-02T03:04:05Z)"},
+		{x: (func())(nil), expected: "(func())(nil)"},
+	}
+
+	for _, testCase := range testCases {
+		actual := formatValue(testCase.x)
+
+		if actual != testCase.expected {
+			t.Fatalf("Expected formatValue(%#v) to be %q but was %q", testCase.x, testCase.expected, actual)
+		}
+	}
+}
+
+func TestFormatValueTruncatesLargeCollections(t *testing.T) {
+	// Arrange.
+	x := make([]int, prettyMaxElements+8)
+
+	// Act.
+	actual := formatValue(x)
+
+	// Assert.
+	if !strings.HasSuffix(actual, "0, 0, ... (8 more),\n}") {
+		t.Fatalf("Expected truncated slice but was %q", actual)
+	}
+
+	if strings.Count(actual, "0") != prettyMaxElements {
+		t.Fatalf("Expected %v elements to be printed but was %q", prettyMaxElements, actual)
+	}
+}
+
+func TestFormatValueSplitsWideValues(t *testing.T) {
+	// Arrange.
+	type address struct {
+		Street string
+		City   string
+	}
+
+	type person struct {
+		Name    string
+		Address address
+		Tags    []string
+	}
+
+	x := person{
+		Name:    "Ada Lovelace",
+		Address: address{Street: "12 St James's Square", City: "London"},
+		Tags:    []string{"mathematician"},
+	}
+
+	// Act.
+	actual := formatValue(x)
+
+	// Assert.
+	expected := `test.person{
+  Name: "Ada Lovelace",
+  Address: test.address{Street: "12 St James's Square", City: "London"},
+  Tags: []string{"mathematician"},
+}`
+
+	if actual != expected {
+		t.Fatalf("Expected formatValue to be\n%v\nbut was\n%v", expected, actual)
+	}
+}
+
+func TestSprintf(t *testing.T) {
+	testCases := []struct {
+		format   string
+		args     []interface{}
+		expected string
+	}{
+		{format: "%v", args: []interface{}{"a"}, expected: `"a"`},
+		{format: "%s", args: []interface{}{"a"}, expected: "a"},
+		{format: "%q", args: []interface{}{"a"}, expected: `"a"`},
+		{format: "%d and %v", args: []interface{}{uint8(3), uint8(3)}, expected: "3 and uint8(3)"},
+		{format: "%5.2f", args: []interface{}{3.14159}, expected: " 3.14"},
+		{format: "%+v", args: []interface{}{struct{ A int }{A: 1}}, expected: "{A:1}"},
+	}
+
+	for _, testCase := range testCases {
+		actual := sprintf(testCase.format, testCase.args...)
+
+		if actual != testCase.expected {
+			t.Fatalf("Expected sprintf(%q) to be %q but was %q", testCase.format, testCase.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,222 @@
+package test
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// AllSatisfy fails the test if any element of the subject, x, does not
+// satisfy condition.  The subject may be a slice, array, map or channel; for
+// maps the condition is applied to each value.  Channels are drained of the
+// values buffered in them, stopping early if they are closed.  The condition
+// may be a Matcher or a function taking one argument and returning a bool.
+// Every element that does not satisfy the condition is reported.
+func (a *Assertions) AllSatisfy(condition interface{}) {
+	a.t.Helper()
+
+	if m, failed := a.evaluate(allSatisfy(condition)); failed {
+		formattedFailure(a, m.format, m.args...)
+	}
+}
+
+// AnySatisfy fails the test if no element of the subject, x, satisfies
+// condition.  See AllSatisfy for the accepted subjects and conditions.  Every
+// element that was checked is reported.
+func (a *Assertions) AnySatisfy(condition interface{}) {
+	a.t.Helper()
+
+	if m, failed := a.evaluate(anySatisfy(condition)); failed {
+		formattedFailure(a, m.format, m.args...)
+	}
+}
+
+// NoneSatisfy fails the test if any element of the subject, x, satisfies
+// condition.  See AllSatisfy for the accepted subjects and conditions.  Every
+// element that satisfies the condition is reported.
+func (a *Assertions) NoneSatisfy(condition interface{}) {
+	a.t.Helper()
+
+	if m, failed := a.Not().evaluate(anySatisfy(condition)); failed {
+		formattedFailure(a, m.format, m.args...)
+	}
+}
+
+// ExactlyNSatisfy fails the test if the number of elements of the subject, x,
+// that satisfy condition is not n.  See AllSatisfy for the accepted subjects
+// and conditions.
+func (a *Assertions) ExactlyNSatisfy(n int, condition interface{}) {
+	a.t.Helper()
+
+	if m, failed := a.evaluate(exactlyNSatisfy(n, condition)); failed {
+		formattedFailure(a, m.format, m.args...)
+	}
+}
+
+func allSatisfy(condition interface{}) predicate {
+	return withQuantifier(condition, func(x interface{}, description string, matched []element, unmatched []element) outcome {
+		if len(unmatched) > 0 {
+			return failed("Expected every element of %v to %s, but %v of %v did not%s", x, description, len(unmatched), len(matched)+len(unmatched), formatElements(unmatched))
+		}
+
+		return passed("Expected some element of %v to not %s, but all %v did", x, description, len(matched))
+	})
+}
+
+func anySatisfy(condition interface{}) predicate {
+	return withQuantifier(condition, func(x interface{}, description string, matched []element, unmatched []element) outcome {
+		if len(matched) == 0 {
+			return failed("Expected some element of %v to %s, but none of %v did%s", x, description, len(unmatched), formatElements(unmatched))
+		}
+
+		return passed("Expected no element of %v to %s, but %v of %v did%s", x, description, len(matched), len(matched)+len(unmatched), formatElements(matched))
+	})
+}
+
+func exactlyNSatisfy(n int, condition interface{}) predicate {
+	return withQuantifier(condition, func(x interface{}, description string, matched []element, unmatched []element) outcome {
+		if len(matched) != n {
+			return failed("Expected exactly %v elements of %v to %s, but %v did%s", n, x, description, len(matched), formatElements(matched))
+		}
+
+		return passed("Expected other than %v elements of %v to %s, but %v did%s", n, x, description, len(matched), formatElements(matched))
+	})
+}
+
+// withQuantifier returns a predicate that splits the elements of the subject
+// into those that satisfy condition and those that do not, and applies p.  The
+// outcome is invalid if the subject is not a collection, the condition is not
+// usable, or the condition cannot be applied to an element.
+func withQuantifier(condition interface{}, p func(x interface{}, description string, matched []element, unmatched []element) outcome) predicate {
+	return func(x interface{}) outcome {
+		elements, ok := baseElements(x)
+		if !ok {
+			return invalid("Expected a slice, array, map or channel, but was not\nx: %s", typeNameFor(x))
+		}
+
+		test, description, ok := conditionFor(condition)
+		if !ok {
+			return invalid("Expected a Matcher or a function taking one argument and returning a bool, but was not\ncondition: %s", typeNameFor(condition))
+		}
+
+		var matched, unmatched []element
+		for _, e := range elements {
+			o := test(e.value)
+			if o.invalid {
+				return invalid("Expected every element of %v to be testable, but element %s was not\n\n%s", x, e.label, o.failure)
+			}
+
+			if o.pass {
+				matched = append(matched, e)
+			} else {
+				unmatched = append(unmatched, e)
+			}
+		}
+
+		return p(x, description, matched, unmatched)
+	}
+}
+
+// conditionFor returns a predicate for condition and a description of it,
+// or false if condition is neither a Matcher nor a function taking one
+// argument and returning a bool.
+func conditionFor(condition interface{}) (predicate, string, bool) {
+	if m, ok := condition.(Matcher); ok {
+		return predicateFor(m), m.Describe(), true
+	}
+
+	fv := reflect.ValueOf(condition)
+	if fv.Kind() != reflect.Func || fv.IsNil() {
+		return nil, "", false
+	}
+
+	ft := fv.Type()
+	if ft.NumIn() != 1 || ft.NumOut() != 1 || ft.Out(0).Kind() != reflect.Bool {
+		return nil, "", false
+	}
+
+	in := ft.In(0)
+
+	return func(x interface{}) outcome {
+		xv := reflect.ValueOf(x)
+		if !xv.IsValid() {
+			switch in.Kind() {
+			case reflect.Interface, reflect.Ptr, reflect.Slice, reflect.Map, reflect.Func, reflect.Chan:
+				xv = reflect.Zero(in)
+			}
+		}
+
+		if !xv.IsValid() || !xv.Type().AssignableTo(in) {
+			return invalid("Expected an element assignable to %s, but was not\nx: %s", in, typeNameFor(x))
+		}
+
+		if fv.Call([]reflect.Value{xv})[0].Bool() {
+			return passed("")
+		}
+
+		return failed("")
+	}, "satisfy the condition", true
+}
+
+// element is a member of a collection, labelled by its index or key.
+type element struct {
+	label string
+	value interface{}
+}
+
+// baseElements returns the elements of a slice, array, map or channel.  Map
+// elements are the values, labelled and sorted by key.  Channels are drained
+// of their buffered values without blocking.
+func baseElements(x interface{}) ([]element, bool) {
+	xv := reflect.ValueOf(x)
+
+	switch xv.Kind() {
+	case reflect.Slice, reflect.Array:
+		elements := make([]element, xv.Len())
+		for i := range elements {
+			elements[i] = element{label: fmt.Sprintf("[%v]", i), value: xv.Index(i).Interface()}
+		}
+
+		return elements, true
+
+	case reflect.Map:
+		keys := xv.MapKeys()
+		sortValues(keys)
+
+		elements := make([]element, len(keys))
+		for i, key := range keys {
+			elements[i] = element{label: fmt.Sprintf("[%v]", formatMapKey(key)), value: xv.MapIndex(key).Interface()}
+		}
+
+		return elements, true
+
+	case reflect.Chan:
+		if xv.Type().ChanDir()&reflect.RecvDir == 0 {
+			return nil, false
+		}
+
+		var elements []element
+		for {
+			v, ok := xv.TryRecv()
+			if !ok {
+				break
+			}
+
+			elements = append(elements, element{label: fmt.Sprintf("[%v]", len(elements)), value: v.Interface()})
+		}
+
+		return elements, true
+	}
+
+	return nil, false
+}
+
+// formatElements lists elements one per line, each preceded by a newline.
+func formatElements(elements []element) string {
+	sb := &strings.Builder{}
+	for _, e := range elements {
+		fmt.Fprintf(sb, "\n  %v: %v", e.label, formatDiffValue(reflect.ValueOf(e.value)))
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,147 @@
+package test
+
+import "testing"
+
+func TestAllSatisfy(t *testing.T) {
+	testCases := []struct {
+		x       interface{}
+		pass    bool
+		message string
+	}{
+		{x: []int{1, 2, 3}, pass: true},
+		{x: [2]int{4, 5}, pass: true},
+		{x: []int{}, pass: true},
+		{x: []int{1, -2, 3, 0}, pass: false, message: "Expected every element of []int{1, -2, 3, 0} to be positive, but 2 of 4 did not\n  [1]: -2\n  [3]: 0"},
+		{x: map[string]int{"b": -1, "a": 1}, pass: false, message: "Expected every element of map[string]int{\"a\": 1, \"b\": -1} to be positive, but 1 of 2 did not\n  [\"b\"]: -1"},
+	}
+
+	for _, testCase := range testCases {
+		recorder := NewRecorder()
+		That(recorder, testCase.x).AllSatisfy(Positive())
+
+		if testCase.pass {
+			assertPassed(t, recorder)
+			assertHelperCount(t, recorder, 2)
+		} else {
+			assertFailed(t, recorder)
+			assertHelperCount(t, recorder, 3)
+			assertFailureMessage(t, recorder, testCase.message)
+		}
+	}
+}
+
+func TestAllSatisfyFunction(t *testing.T) {
+	type order struct {
+		ID    string
+		Total int
+	}
+
+	// Arrange.
+	orders := []order{{ID: "a", Total: 5}, {ID: "b", Total: 0}}
+	recorder := NewRecorder()
+
+	// Act.
+	That(recorder, orders).AllSatisfy(func(o order) bool { return o.Total > 0 })
+
+	// Assert.
+	assertFailed(t, recorder)
+	assertFailureMessage(t, recorder, "to satisfy the condition, but 1 of 2 did not\n  [1]: test.order{ID: \"b\", Total: 0}")
+}
+
+func TestAnySatisfy(t *testing.T) {
+	// Arrange.
+	pass := NewRecorder()
+	fail := NewRecorder()
+
+	// Act.
+	That(pass, []string{"apple", "banana"}).AnySatisfy(StartsWith("b"))
+	That(fail, []string{"apple", "cherry"}).AnySatisfy(StartsWith("b"))
+
+	drained := NewRecorder()
+	ch := make(chan int, 2)
+	ch <- 1
+	ch <- 3
+	That(drained, ch).AnySatisfy(func(x int) bool { return x%2 == 0 })
+
+	// Assert.
+	assertPassed(t, pass)
+	assertFailed(t, fail)
+	assertFailureMessage(t, fail, "Expected some element of []string{\"apple\", \"cherry\"} to start with \"b\", but none of 2 did\n  [0]: \"apple\"\n  [1]: \"cherry\"")
+
+	assertFailed(t, drained)
+	assertFailureMessage(t, drained, "to satisfy the condition, but none of 2 did\n  [0]: 1\n  [1]: 3")
+}
+
+func TestNoneSatisfy(t *testing.T) {
+	// Arrange.
+	pass := NewRecorder()
+	fail := NewRecorder()
+
+	// Act.
+	That(pass, []int{1, 3, 5}).NoneSatisfy(func(x int) bool { return x%2 == 0 })
+	That(fail, []int{1, 2, 4}).NoneSatisfy(func(x int) bool { return x%2 == 0 })
+
+	// Assert.
+	assertPassed(t, pass)
+	assertFailed(t, fail)
+	assertFailureMessage(t, fail, "Expected no element of []int{1, 2, 4} to satisfy the condition, but 2 of 3 did\n  [1]: 2\n  [2]: 4")
+}
+
+func TestExactlyNSatisfy(t *testing.T) {
+	// Arrange.
+	pass := NewRecorder()
+	fail := NewRecorder()
+	none := NewRecorder()
+
+	// Act.
+	That(pass, []int{1, 2, 4}).ExactlyNSatisfy(2, Even())
+	That(fail, []int{1, 2, 3}).ExactlyNSatisfy(2, Even())
+	That(none, []int{1, 3}).ExactlyNSatisfy(1, Even())
+
+	// Assert.
+	assertPassed(t, pass)
+	assertFailed(t, fail)
+	assertFailureMessage(t, fail, "Expected exactly 2 elements of []int{1, 2, 3} to be even, but 1 did\n  [1]: 2")
+	assertFailed(t, none)
+	assertFailureMessage(t, none, "Expected exactly 1 elements of []int{1, 3} to be even, but 0 did")
+}
+
+func TestQuantifiersDrainChannels(t *testing.T) {
+	// Arrange.
+	ch := make(chan int, 3)
+	ch <- 1
+	ch <- -1
+	ch <- 2
+	close(ch)
+
+	recorder := NewRecorder()
+
+	// Act.
+	That(recorder, ch).AllSatisfy(Positive())
+
+	// Assert.
+	assertFailed(t, recorder)
+	assertFailureMessage(t, recorder, "but 1 of 3 did not\n  [1]: -1")
+}
+
+func TestQuantifiersExpectCollectionAndCondition(t *testing.T) {
+	testCases := []struct {
+		x         interface{}
+		condition interface{}
+		message   string
+	}{
+		{x: 5, condition: Positive(), message: "Expected a slice, array, map or channel, but was not\nx: int"},
+		{x: []int{1}, condition: 5, message: "Expected a Matcher or a function taking one argument and returning a bool, but was not\ncondition: int"},
+		{x: []int{1}, condition: func(x, y int) bool { return true }, message: "Expected a Matcher or a function taking one argument"},
+		{x: []interface{}{1, "a"}, condition: func(x int) bool { return true }, message: "but element [1] was not\n\nExpected an element assignable to int, but was not\nx: string"},
+		{x: []interface{}{1, "a"}, condition: Positive(), message: "but element [1] was not\n\nExpected a number, but was not\nx: string"},
+	}
+
+	for _, testCase := range testCases {
+		recorder := NewRecorder()
+		That(recorder, testCase.x).Not().AllSatisfy(testCase.condition)
+
+		assertFailed(t, recorder)
+		assertFailureMessage(t, recorder, testCase.message)
+	}
+}
@@ -0,0 +1,227 @@
+package test
+
+import (
+	"math/big"
+	"reflect"
+)
+
+// IsBetween fails the test if the subject, x, is not between lo and hi
+// inclusive.  Numbers of any kind and time.Time values can be compared.
+func (a *Assertions) IsBetween(lo interface{}, hi interface{}) {
+	a.t.Helper()
+
+	if m, failed := a.evaluate(between(lo, hi)); failed {
+		formattedFailure(a, m.format, m.args...)
+	}
+}
+
+// IsStrictlyBetween fails the test if the subject, x, is not between lo and hi
+// exclusive.  Numbers of any kind and time.Time values can be compared.
+func (a *Assertions) IsStrictlyBetween(lo interface{}, hi interface{}) {
+	a.t.Helper()
+
+	if m, failed := a.evaluate(strictlyBetween(lo, hi)); failed {
+		formattedFailure(a, m.format, m.args...)
+	}
+}
+
+// IsPositive fails the test if the subject, x, is not a number greater than
+// zero.
+func (a *Assertions) IsPositive() {
+	a.t.Helper()
+
+	if m, failed := a.evaluate(isPositive()); failed {
+		formattedFailure(a, m.format, m.args...)
+	}
+}
+
+// IsNegative fails the test if the subject, x, is not a number less than zero.
+func (a *Assertions) IsNegative() {
+	a.t.Helper()
+
+	if m, failed := a.evaluate(isNegative()); failed {
+		formattedFailure(a, m.format, m.args...)
+	}
+}
+
+// IsZero fails the test if the subject, x, is not the zero value of its type.
+// Numbers, including math/big numbers, are zero if they are equal to zero.
+func (a *Assertions) IsZero() {
+	a.t.Helper()
+
+	if m, failed := a.evaluate(isZero()); failed {
+		formattedFailure(a, m.format, m.args...)
+	}
+}
+
+// IsNotZero fails the test if the subject, x, is the zero value of its type.
+// Numbers, including math/big numbers, are zero if they are equal to zero.
+func (a *Assertions) IsNotZero() {
+	a.t.Helper()
+
+	if m, failed := a.Not().evaluate(isZero()); failed {
+		formattedFailure(a, m.format, m.args...)
+	}
+}
+
+// IsEven fails the test if the subject, x, is not an even integer.
+func (a *Assertions) IsEven() {
+	a.t.Helper()
+
+	if m, failed := a.evaluate(isEven()); failed {
+		formattedFailure(a, m.format, m.args...)
+	}
+}
+
+// IsOdd fails the test if the subject, x, is not an odd integer.
+func (a *Assertions) IsOdd() {
+	a.t.Helper()
+
+	if m, failed := a.evaluate(isOdd()); failed {
+		formattedFailure(a, m.format, m.args...)
+	}
+}
+
+// IsMultipleOf fails the test if the subject, x, is not an exact integer
+// multiple of y.  Both must be numbers, and y must be finite and non-zero.
+// Floating point values are compared exactly, so 0.3 is not a multiple of 0.1.
+func (a *Assertions) IsMultipleOf(y interface{}) {
+	a.t.Helper()
+
+	if m, failed := a.evaluate(multipleOf(y)); failed {
+		formattedFailure(a, m.format, m.args...)
+	}
+}
+
+func between(lo interface{}, hi interface{}) predicate {
+	return bounded(lo, hi, "inclusive", func(c1 int, c2 int) bool {
+		return (c1 == comparisonGreater || c1 == comparisonEqual) && (c2 == comparisonLess || c2 == comparisonEqual)
+	})
+}
+
+func strictlyBetween(lo interface{}, hi interface{}) predicate {
+	return bounded(lo, hi, "exclusive", func(c1 int, c2 int) bool {
+		return c1 == comparisonGreater && c2 == comparisonLess
+	})
+}
+
+// bounded returns a predicate that compares the subject against lo and hi and
+// passes if accept returns true for the two comparisons.
+func bounded(lo interface{}, hi interface{}, bounds string, accept func(c1 int, c2 int) bool) predicate {
+	return func(x interface{}) outcome {
+		c1, ok1 := baseComparison(x, lo)
+		c2, ok2 := baseComparison(x, hi)
+		if !ok1 || !ok2 {
+			return invalid("Expected three comparable types\nx: %s\nlo: %s\nhi: %s", typeNameFor(x), typeNameFor(lo), typeNameFor(hi))
+		}
+
+		if !accept(c1, c2) {
+			return failed("Expected %v to be between %v and %v %s", x, lo, hi, bounds)
+		}
+
+		return passed("Expected %v to not be between %v and %v %s", x, lo, hi, bounds)
+	}
+}
+
+func isPositive() predicate {
+	return withNumber(func(x interface{}, n number) outcome {
+		if n.compare(zeroNumber()) != comparisonGreater {
+			return failed("Expected %v to be positive", x)
+		}
+
+		return passed("Expected %v to not be positive", x)
+	})
+}
+
+func isNegative() predicate {
+	return withNumber(func(x interface{}, n number) outcome {
+		if n.compare(zeroNumber()) != comparisonLess {
+			return failed("Expected %v to be negative", x)
+		}
+
+		return passed("Expected %v to not be negative", x)
+	})
+}
+
+func isZero() predicate {
+	return func(x interface{}) outcome {
+		if !baseZeroTest(x) {
+			return failed("Expected %v to be the zero value of %s", x, typeNameFor(x))
+		}
+
+		return passed("Expected subject to not be the zero value of %s, but was", typeNameFor(x))
+	}
+}
+
+func isEven() predicate {
+	return withNumber(func(x interface{}, n number) outcome {
+		if !n.isMultipleOf(big.NewRat(2, 1)) {
+			return failed("Expected %v to be even", x)
+		}
+
+		return passed("Expected %v to not be even", x)
+	})
+}
+
+func isOdd() predicate {
+	return withNumber(func(x interface{}, n number) outcome {
+		if !n.isMultipleOf(big.NewRat(1, 1)) || n.isMultipleOf(big.NewRat(2, 1)) {
+			return failed("Expected %v to be odd", x)
+		}
+
+		return passed("Expected %v to not be odd", x)
+	})
+}
+
+func multipleOf(y interface{}) predicate {
+	return withNumber(func(x interface{}, n number) outcome {
+		m, ok := baseNumberValue(y)
+		if !ok || m.rat == nil || m.rat.Sign() == 0 {
+			return invalid("Expected a finite, non-zero divisor, but was %v\ny: %s", y, typeNameFor(y))
+		}
+
+		if !n.isMultipleOf(m.rat) {
+			return failed("Expected %v to be a multiple of %v", x, y)
+		}
+
+		return passed("Expected %v to not be a multiple of %v", x, y)
+	})
+}
+
+// withNumber returns a predicate that applies p to the subject and its value
+// as a number.  The outcome is invalid if the subject is not a number.
+func withNumber(p func(x interface{}, n number) outcome) predicate {
+	return func(x interface{}) outcome {
+		n, ok := baseNumberValue(x)
+		if !ok {
+			return invalid("Expected a number, but was not\nx: %s", typeNameFor(x))
+		}
+
+		return p(x, n)
+	}
+}
+
+func baseZeroTest(x interface{}) bool {
+	if n, ok := baseNumberValue(x); ok {
+		return n.compare(zeroNumber()) == comparisonEqual
+	}
+
+	if x == nil {
+		return true
+	}
+
+	return reflect.ValueOf(x).IsZero()
+}
+
+func zeroNumber() number {
+	return number{rat: new(big.Rat)}
+}
+
+// isMultipleOf reports whether n is finite and an integer multiple of m.
+func (n number) isMultipleOf(m *big.Rat) bool {
+	if n.rat == nil {
+		return false
+	}
+
+	return new(big.Rat).Quo(n.rat, m).IsInt()
+}
@@ -0,0 +1,188 @@
+package test
+
+import (
+	"math"
+	"math/big"
+	"testing"
+	"time"
+)
+
+func TestIsBetween(t *testing.T) {
+	now := time.Now()
+
+	testCases := []struct {
+		x         interface{}
+		lo        interface{}
+		hi        interface{}
+		inclusive bool
+		exclusive bool
+	}{
+		{x: 5, lo: 1, hi: 10, inclusive: true, exclusive: true},
+		{x: 1, lo: 1, hi: 10, inclusive: true, exclusive: false},
+		{x: 10, lo: 1, hi: 10, inclusive: true, exclusive: false},
+		{x: 11, lo: 1, hi: 10, inclusive: false, exclusive: false},
+		{x: 2.5, lo: 2, hi: uint8(3), inclusive: true, exclusive: true},
+		{x: math.NaN(), lo: 0, hi: 1, inclusive: false, exclusive: false},
+		{x: 90 * time.Second, lo: time.Minute, hi: 2 * time.Minute, inclusive: true, exclusive: true},
+		{x: now, lo: now.Add(-time.Second), hi: now, inclusive: true, exclusive: false},
+	}
+
+	for _, testCase := range testCases {
+		inclusive := NewRecorder()
+		exclusive := NewRecorder()
+
+		That(inclusive, testCase.x).IsBetween(testCase.lo, testCase.hi)
+		That(exclusive, testCase.x).IsStrictlyBetween(testCase.lo, testCase.hi)
+
+		assertOutcome(t, inclusive, testCase.inclusive)
+		assertOutcome(t, exclusive, testCase.exclusive)
+
+		if !testCase.inclusive {
+			assertFailureMessage(t, inclusive, "Expected %v to be between %v and %v inclusive", testCase.x, testCase.lo, testCase.hi)
+		}
+
+		if !testCase.exclusive {
+			assertFailureMessage(t, exclusive, "Expected %v to be between %v and %v exclusive", testCase.x, testCase.lo, testCase.hi)
+		}
+	}
+}
+
+func TestIsBetweenExpectsComparableTypes(t *testing.T) {
+	// Arrange.
+	recorder := NewRecorder()
+
+	// Act.
+	That(recorder, 5).IsBetween(1, "10")
+
+	// Assert.
+	assertFailed(t, recorder)
+	assertFailureMessage(t, recorder, "Expected three comparable types\nx: int\nlo: int\nhi: string")
+}
+
+func TestSignAssertions(t *testing.T) {
+	testCases := []struct {
+		x        interface{}
+		positive bool
+		negative bool
+	}{
+		{x: 5, positive: true},
+		{x: -5, negative: true},
+		{x: 0},
+		{x: uint64(math.MaxUint64), positive: true},
+		{x: -0.5, negative: true},
+		{x: math.Inf(1), positive: true},
+		{x: math.NaN()},
+		{x: -time.Second, negative: true},
+		{x: big.NewInt(-1), negative: true},
+	}
+
+	for _, testCase := range testCases {
+		positive := NewRecorder()
+		negative := NewRecorder()
+
+		That(positive, testCase.x).IsPositive()
+		That(negative, testCase.x).IsNegative()
+
+		assertOutcome(t, positive, testCase.positive)
+		assertOutcome(t, negative, testCase.negative)
+	}
+}
+
+func TestSignAssertionsExpectNumber(t *testing.T) {
+	// Arrange.
+	recorder := NewRecorder()
+
+	// Act.
+	That(recorder, "5").IsPositive()
+
+	// Assert.
+	assertFailed(t, recorder)
+	assertHelperCount(t, recorder, 3)
+	assertFailureMessage(t, recorder, "Expected a number, but was not\nx: string")
+}
+
+func TestIsZero(t *testing.T) {
+	type config struct {
+		Name string
+		Tags []string
+	}
+
+	testCases := []struct {
+		x    interface{}
+		zero bool
+	}{
+		{x: 0, zero: true},
+		{x: 0.0, zero: true},
+		{x: 1, zero: false},
+		{x: "", zero: true},
+		{x: "a", zero: false},
+		{x: nil, zero: true},
+		{x: []int(nil), zero: true},
+		{x: []int{}, zero: false},
+		{x: config{}, zero: true},
+		{x: config{Name: "a"}, zero: false},
+		{x: time.Time{}, zero: true},
+		{x: time.Now(), zero: false},
+		{x: time.Duration(0), zero: true},
+		{x: big.NewInt(0), zero: true},
+		{x: (*config)(nil), zero: true},
+	}
+
+	for _, testCase := range testCases {
+		isZero := NewRecorder()
+		isNotZero := NewRecorder()
+
+		That(isZero, testCase.x).IsZero()
+		That(isNotZero, testCase.x).IsNotZero()
+
+		assertOutcome(t, isZero, testCase.zero)
+		assertOutcome(t, isNotZero, !testCase.zero)
+	}
+}
+
+func TestParityAndMultiples(t *testing.T) {
+	testCases := []struct {
+		x          interface{}
+		even       bool
+		odd        bool
+		multipleOf interface{}
+		multiple   bool
+	}{
+		{x: 4, even: true, multipleOf: 2, multiple: true},
+		{x: 7, odd: true, multipleOf: 2, multiple: false},
+		{x: -3, odd: true, multipleOf: 3, multiple: true},
+		{x: 0, even: true, multipleOf: 5, multiple: true},
+		{x: 4.0, even: true, multipleOf: 0.5, multiple: true},
+		{x: 4.5, multipleOf: 1.5, multiple: true},
+		{x: 0.3, multipleOf: 0.1, multiple: false},
+		{x: uint64(math.MaxUint64), odd: true, multipleOf: uint64(5), multiple: true},
+		{x: 90 * time.Second, even: true, multipleOf: 30 * time.Second, multiple: true},
+		{x: math.Inf(1), multipleOf: 2, multiple: false},
+	}
+
+	for _, testCase := range testCases {
+		even := NewRecorder()
+		odd := NewRecorder()
+		multiple := NewRecorder()
+
+		That(even, testCase.x).IsEven()
+		That(odd, testCase.x).IsOdd()
+		That(multiple, testCase.x).IsMultipleOf(testCase.multipleOf)
+
+		assertOutcome(t, even, testCase.even)
+		assertOutcome(t, odd, testCase.odd)
+		assertOutcome(t, multiple, testCase.multiple)
+	}
+}
+
+func TestIsMultipleOfExpectsNonZeroNumber(t *testing.T) {
+	// Arrange.
+	recorder := NewRecorder()
+
+	// Act.
+	That(recorder, 5).IsMultipleOf(0)
+
+	// Assert.
+	assertFailed(t, recorder)
+	assertFailureMessage(t, recorder, "Expected a finite, non-zero divisor, but was 0\ny: int")
+}
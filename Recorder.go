@@ -8,6 +8,7 @@ type Recorder struct {
 	HelperCallCount int
 	DidFail         bool
 	FailMessage     string
+	ErrorMessages   []string
 }
 
 var _ T = &Recorder{}
@@ -27,6 +28,14 @@ func (r *Recorder) Helper() {
 	r.HelperCallCount++
 }
 
+// Errorf sets `DidFail` to true, sets `FailMessage` to the failure message and
+// appends it to `ErrorMessages`.
+func (r *Recorder) Errorf(format string, args ...interface{}) {
+	r.DidFail = true
+	r.FailMessage = fmt.Sprintf(format, args...)
+	r.ErrorMessages = append(r.ErrorMessages, r.FailMessage)
+}
+
 // Fatalf sets `didFail` to true and sets `failMessage` to the failure message.
 func (r *Recorder) Fatalf(format string, args ...interface{}) {
 	r.DidFail = true
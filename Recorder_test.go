@@ -59,3 +59,25 @@ func TestRecorderFatalf(t *testing.T) {
 		t.Fatalf("Expected FailMessage to be 'Something went wrong' but was '%v'", sut.FailMessage)
 	}
 }
+
+func TestRecorderErrorf(t *testing.T) {
+	// Arrange.
+	sut := NewRecorder()
+
+	// Act.
+	sut.Errorf("First %v", "failure")
+	sut.Errorf("Second %v", "failure")
+
+	// Assert.
+	if !sut.DidFail {
+		t.Fatalf("Expected DidFail to be true, but was false")
+	}
+
+	if sut.FailMessage != "Second failure" {
+		t.Fatalf("Expected FailMessage to be 'Second failure' but was '%v'", sut.FailMessage)
+	}
+
+	if len(sut.ErrorMessages) != 2 || sut.ErrorMessages[0] != "First failure" {
+		t.Fatalf("Expected ErrorMessages to hold both failures but was %v", sut.ErrorMessages)
+	}
+}
@@ -0,0 +1,183 @@
+package test
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// MatchesInlineSnapshot fails the test if the subject, x, serialized as for
+// MatchesGolden, is not equal to snapshot.  When golden files are being
+// updated, as described for MatchesGolden, the string literal passed as
+// snapshot is rewritten in the source of the test with the serialized subject
+// instead.  The snapshot must then be a string
+// literal, only the first call to MatchesInlineSnapshot on a line can be
+// rewritten, and the test must not be built with -trimpath, so that its source
+// can be found.
+func (a *Assertions) MatchesInlineSnapshot(snapshot string) {
+	a.t.Helper()
+
+	_, file, line, _ := runtime.Caller(1)
+
+	if m, failed := a.evaluate(matchesInlineSnapshot(snapshot, file, line)); failed {
+		formattedFailure(a, m.format, m.args...)
+	}
+}
+
+func matchesInlineSnapshot(snapshot string, file string, line int) predicate {
+	return func(x interface{}) outcome {
+		b, err := goldenContentFor(x)
+		if err != nil {
+			return invalid("Expected a subject that can be written to a snapshot, but it could not be serialized: %s\nx: %s", err, typeNameFor(x))
+		}
+
+		actual := string(b)
+
+		if updatingGoldenFiles() {
+			if err := rewriteInlineSnapshot(file, line, actual); err != nil {
+				return invalid("Expected the inline snapshot at %s:%v to be rewritten, but it could not be: %s", file, line, err)
+			}
+
+			return passed("Expected subject to not match inline snapshot, but it was rewritten with the subject")
+		}
+
+		if actual != snapshot {
+			if diff := stringDiffFor(actual, snapshot); diff != "" {
+				return failed("Expected subject to match inline snapshot, but it differs\n\n%s", diff)
+			}
+
+			return failed("Expected %v to match inline snapshot %v", actual, snapshot)
+		}
+
+		return passed("Expected %v to not match inline snapshot %v, but it did", actual, snapshot)
+	}
+}
+
+// snapshotMu guards the rewriting of test sources and snapshotShifts.
+var snapshotMu sync.Mutex
+
+// snapshotShifts records, for each rewritten file, how lines have moved since
+// the test binary was compiled, so that the line numbers reported by the
+// runtime can still be found after earlier snapshots in the file have grown or
+// shrunk.
+var snapshotShifts = map[string][]lineShift{}
+
+// lineShift records that every line after line, as compiled, has moved by
+// delta lines.
+type lineShift struct {
+	line  int
+	delta int
+}
+
+// rewriteInlineSnapshot replaces the snapshot argument of the call to
+// MatchesInlineSnapshot on the provided line of file with a string literal
+// for value.  The line is as compiled, before any rewrites.
+func rewriteInlineSnapshot(file string, line int, value string) error {
+	if !filepath.IsAbs(file) {
+		return fmt.Errorf("the path of the test source, %s, is not absolute, as when the test is built with -trimpath", file)
+	}
+
+	snapshotMu.Lock()
+	defer snapshotMu.Unlock()
+
+	src, err := os.ReadFile(file)
+	if err != nil {
+		return err
+	}
+
+	current := line
+	for _, shift := range snapshotShifts[file] {
+		if shift.line < line {
+			current += shift.delta
+		}
+	}
+
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, file, src, parser.ParseComments)
+	if err != nil {
+		return err
+	}
+
+	call := snapshotCallAt(fset, f, current)
+	if call == nil {
+		return fmt.Errorf("no call to MatchesInlineSnapshot was found on line %v", current)
+	}
+
+	lit, ok := call.Args[0].(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return fmt.Errorf("the snapshot is not a string literal")
+	}
+
+	start := fset.Position(lit.Pos()).Offset
+	end := fset.Position(lit.End()).Offset
+	replacement := snapshotLiteralFor(value)
+
+	var out []byte
+	out = append(out, src[:start]...)
+	out = append(out, replacement...)
+	out = append(out, src[end:]...)
+
+	info, err := os.Stat(file)
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(file, out, info.Mode()); err != nil {
+		return err
+	}
+
+	delta := strings.Count(replacement, "\n") - strings.Count(lit.Value, "\n")
+	if delta != 0 {
+		snapshotShifts[file] = append(snapshotShifts[file], lineShift{line: line, delta: delta})
+	}
+
+	return nil
+}
+
+// snapshotCallAt returns the call to MatchesInlineSnapshot with a single
+// argument that spans the provided line, from its method name to its closing
+// parenthesis.  Of several such calls, the one whose method name is latest
+// but not after the line is returned.
+func snapshotCallAt(fset *token.FileSet, f *ast.File, line int) *ast.CallExpr {
+	var found *ast.CallExpr
+	foundLine := 0
+
+	ast.Inspect(f, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok || len(call.Args) != 1 {
+			return true
+		}
+
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok || sel.Sel.Name != "MatchesInlineSnapshot" {
+			return true
+		}
+
+		from := fset.Position(sel.Sel.Pos()).Line
+		to := fset.Position(call.Rparen).Line
+		if from <= line && line <= to && from > foundLine {
+			found, foundLine = call, from
+		}
+
+		return true
+	})
+
+	return found
+}
+
+// snapshotLiteralFor returns a Go string literal for s, preferring a raw
+// string literal so that multi-line snapshots remain readable.
+func snapshotLiteralFor(s string) string {
+	if !strconv.CanBackquote(strings.ReplaceAll(s, "\n", "")) {
+		return strconv.Quote(s)
+	}
+
+	return "`" + s + "`"
+}
@@ -0,0 +1,111 @@
+package test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestMatchesInlineSnapshot(t *testing.T) {
+	disableGoldenUpdates(t)
+
+	testCases := []struct {
+		x        interface{}
+		snapshot string
+		pass     bool
+		message  string
+	}{
+		{x: "ok", snapshot: "ok", pass: true},
+		{x: []byte("ok"), snapshot: "ok", pass: true},
+		{x: map[string]int{"a": 1}, snapshot: "{\n  \"a\": 1\n}\n", pass: true},
+		{x: "ok", snapshot: "not ok", pass: false, message: `Expected "ok" to match inline snapshot "not ok"`},
+		{x: "a\nb", snapshot: "a\nc", pass: false, message: "Expected subject to match inline snapshot, but it differs\n\n--- x\n+++ y\n@@ -1,2 +1,2 @@\n a\n-[-b-]\n+{+c+}"},
+	}
+
+	for _, testCase := range testCases {
+		recorder := NewRecorder()
+		That(recorder, testCase.x).MatchesInlineSnapshot(testCase.snapshot)
+
+		if !testCase.pass {
+			assertFailed(t, recorder)
+			assertHelperCount(t, recorder, 3)
+			assertFailureMessage(t, recorder, testCase.message)
+		} else {
+			assertPassed(t, recorder)
+			assertHelperCount(t, recorder, 2)
+		}
+	}
+}
+
+func TestRewriteInlineSnapshot(t *testing.T) {
+	// Arrange.
+	file := filepath.Join(t.TempDir(), "example_test.go")
+	writeSource(t, file, "package example\n\nfunc TestExample(t *testing.T) {\n\ttest.That(t, a).MatchesInlineSnapshot(\"\")\n\ttest.That(t, b).\n\t\tMatchesInlineSnapshot(`old`)\n\ttest.That(t, c).MatchesInlineSnapshot(\"`\")\n}\n")
+
+	// Act.
+	errs := []error{
+		rewriteInlineSnapshot(file, 4, "first\nsecond\n"),
+		rewriteInlineSnapshot(file, 6, "new"),
+		rewriteInlineSnapshot(file, 7, "back`quote"),
+	}
+
+	// Assert.
+	for _, err := range errs {
+		if err != nil {
+			t.Fatalf("Expected rewrite to succeed but %v", err)
+		}
+	}
+
+	expected := "package example\n\nfunc TestExample(t *testing.T) {\n\ttest.That(t, a).MatchesInlineSnapshot(`first\nsecond\n`)\n\ttest.That(t, b).\n\t\tMatchesInlineSnapshot(`new`)\n\ttest.That(t, c).MatchesInlineSnapshot(\"back`quote\")\n}\n"
+	if actual := readSource(t, file); actual != expected {
+		t.Fatalf("Expected source to be\n%v\nbut was\n%v", expected, actual)
+	}
+}
+
+func TestRewriteInlineSnapshotRequiresStringLiteral(t *testing.T) {
+	// Arrange.
+	file := filepath.Join(t.TempDir(), "example_test.go")
+	writeSource(t, file, "package example\n\nfunc TestExample(t *testing.T) {\n\ttest.That(t, a).MatchesInlineSnapshot(expected)\n}\n")
+
+	// Act.
+	err := rewriteInlineSnapshot(file, 4, "new")
+	missing := rewriteInlineSnapshot(file, 3, "new")
+
+	// Assert.
+	if err == nil || err.Error() != "the snapshot is not a string literal" {
+		t.Fatalf("Unexpected error '%v'", err)
+	}
+
+	if missing == nil || missing.Error() != "no call to MatchesInlineSnapshot was found on line 3" {
+		t.Fatalf("Unexpected error '%v'", missing)
+	}
+}
+
+func TestRewriteInlineSnapshotRequiresAbsolutePath(t *testing.T) {
+	// Act.
+	err := rewriteInlineSnapshot("github.com/example/example_test.go", 4, "new")
+
+	// Assert.
+	if err == nil || err.Error() != "the path of the test source, github.com/example/example_test.go, is not absolute, as when the test is built with -trimpath" {
+		t.Fatalf("Unexpected error '%v'", err)
+	}
+}
+
+func writeSource(t *testing.T, file string, src string) {
+	t.Helper()
+
+	if err := os.WriteFile(file, []byte(src), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func readSource(t *testing.T, file string) string {
+	t.Helper()
+
+	b, err := os.ReadFile(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return string(b)
+}
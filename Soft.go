@@ -0,0 +1,78 @@
+package test
+
+import (
+	"fmt"
+	"sync"
+)
+
+// SoftT marks a T so that assertions made against it report failures with
+// Errorf rather than Fatalf.  The test continues after a failure, and every
+// failure is collected so that they are all reported together.  Assertions
+// report directly to the T that was marked, so failures are attributed to the
+// line of the test that made the assertion.
+type SoftT struct {
+	t T
+
+	mx       sync.Mutex
+	failures []string
+}
+
+var _ T = &SoftT{}
+
+// Soft returns a new *SoftT that collects the failures of any assertions made
+// against it without stopping the test.
+func Soft(t T) *SoftT {
+	return &SoftT{t: t}
+}
+
+// Check returns a new *Assertions like That, except that a failed assertion
+// does not stop the test.
+func Check(t T, x interface{}) *Assertions {
+	a := assertionsFor(Soft(t), x)
+	a.t.Helper()
+
+	return a
+}
+
+// Name returns the name of the marked T.
+func (s *SoftT) Name() string {
+	return s.t.Name()
+}
+
+// Helper marks the calling function as a helper on the marked T.  It is only
+// called when a *SoftT is used as a T outside of this package.
+func (s *SoftT) Helper() {
+	s.t.Helper()
+}
+
+// Errorf records the failure and reports it to the marked T with Errorf.
+func (s *SoftT) Errorf(format string, args ...interface{}) {
+	s.t.Helper()
+
+	s.record(format, args...)
+	s.t.Errorf(format, args...)
+}
+
+// Fatalf records the failure and reports it to the marked T with Errorf, so
+// that the test continues.
+func (s *SoftT) Fatalf(format string, args ...interface{}) {
+	s.t.Helper()
+
+	s.record(format, args...)
+	s.t.Errorf(format, args...)
+}
+
+// Failures returns the messages of every failure collected so far.
+func (s *SoftT) Failures() []string {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	return append([]string(nil), s.failures...)
+}
+
+func (s *SoftT) record(format string, args ...interface{}) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	s.failures = append(s.failures, fmt.Sprintf(format, args...))
+}
@@ -0,0 +1,131 @@
+package test
+
+import (
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func TestSoftCollectsAllFailures(t *testing.T) {
+	// Arrange.
+	recorder := NewRecorder()
+	sut := Soft(recorder)
+
+	// Act.
+	That(sut, 4).IsEqualTo(5)
+	That(sut, true).IsTrue()
+	That(sut, "Hello").IsNil()
+
+	// Assert.
+	assertFailed(t, recorder)
+
+	if len(recorder.ErrorMessages) != 2 {
+		t.Fatalf("Expected 2 failures to be reported with Errorf but was %v", len(recorder.ErrorMessages))
+	}
+
+	failures := sut.Failures()
+	if len(failures) != 2 {
+		t.Fatalf("Expected 2 failures to be collected but was %v", len(failures))
+	}
+
+	if !strings.Contains(failures[0], "Expected 4 to be equal to 5") {
+		t.Fatalf("Unexpected first failure '%v'", failures[0])
+	}
+
+	if !strings.Contains(failures[1], "Expected \"Hello\" to be <nil>") {
+		t.Fatalf("Unexpected second failure '%v'", failures[1])
+	}
+}
+
+func TestCheck(t *testing.T) {
+	testCases := []struct {
+		x    interface{}
+		y    interface{}
+		pass bool
+	}{
+		{x: 4, y: 4, pass: true},
+		{x: 4, y: 5, pass: false},
+	}
+
+	for _, testCase := range testCases {
+		recorder := NewRecorder()
+		Check(recorder, testCase.x).IsEqualTo(testCase.y)
+
+		if !testCase.pass {
+			assertFailed(t, recorder)
+			assertFailureMessage(t, recorder, "Expected %v to be equal to %v", testCase.x, testCase.y)
+
+			if len(recorder.ErrorMessages) != 1 {
+				t.Fatalf("Expected the failure to be reported with Errorf")
+			}
+		} else {
+			assertPassed(t, recorder)
+		}
+	}
+}
+
+func TestSoftReportsCallerLine(t *testing.T) {
+	// Arrange.
+	recorder := newCallerRecorder()
+	sut := Soft(recorder)
+
+	// Act.
+	_, _, line, _ := runtime.Caller(0)
+	That(sut, 4).IsEqualTo(5)
+	Check(recorder, 4).IsEqualTo(5)
+
+	// Assert.
+	assertFailed(t, &recorder.Recorder)
+
+	if len(recorder.lines) != 2 || recorder.lines[0] != line+1 || recorder.lines[1] != line+2 {
+		t.Fatalf("Expected failures to be reported at lines %v and %v but were %v", line+1, line+2, recorder.lines)
+	}
+}
+
+// callerRecorder is a Recorder that also records the line each failure is
+// reported at, skipping functions marked as helpers as *testing.T does.
+type callerRecorder struct {
+	Recorder
+
+	helpers map[string]bool
+	lines   []int
+}
+
+var _ T = &callerRecorder{}
+
+func newCallerRecorder() *callerRecorder {
+	return &callerRecorder{helpers: make(map[string]bool)}
+}
+
+func (r *callerRecorder) Helper() {
+	r.Recorder.Helper()
+
+	var pc [1]uintptr
+	runtime.Callers(2, pc[:])
+
+	frame, _ := runtime.CallersFrames(pc[:]).Next()
+	r.helpers[frame.Function] = true
+}
+
+func (r *callerRecorder) Errorf(format string, args ...interface{}) {
+	r.recordLine()
+	r.Recorder.Errorf(format, args...)
+}
+
+func (r *callerRecorder) Fatalf(format string, args ...interface{}) {
+	r.recordLine()
+	r.Recorder.Fatalf(format, args...)
+}
+
+func (r *callerRecorder) recordLine() {
+	pc := make([]uintptr, 32)
+	frames := runtime.CallersFrames(pc[:runtime.Callers(3, pc)])
+
+	for {
+		frame, more := frames.Next()
+		if !r.helpers[frame.Function] || !more {
+			r.lines = append(r.lines, frame.Line)
+			return
+		}
+	}
+}
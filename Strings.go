@@ -0,0 +1,298 @@
+package test
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// StartsWith fails the test if the subject, x, does not start with prefix.
+// The subject may be a string, []byte, error or fmt.Stringer.
+func (a *Assertions) StartsWith(prefix string) {
+	a.t.Helper()
+
+	if m, failed := a.evaluate(startsWith(prefix)); failed {
+		formattedFailure(a, m.format, m.args...)
+	}
+}
+
+// EndsWith fails the test if the subject, x, does not end with suffix.  The
+// subject may be a string, []byte, error or fmt.Stringer.
+func (a *Assertions) EndsWith(suffix string) {
+	a.t.Helper()
+
+	if m, failed := a.evaluate(endsWith(suffix)); failed {
+		formattedFailure(a, m.format, m.args...)
+	}
+}
+
+// ContainsSubstring fails the test if the subject, x, does not contain
+// substr.  The subject may be a string, []byte, error or fmt.Stringer.
+func (a *Assertions) ContainsSubstring(substr string) {
+	a.t.Helper()
+
+	if m, failed := a.evaluate(containsSubstring(substr)); failed {
+		formattedFailure(a, m.format, m.args...)
+	}
+}
+
+// MatchesRegexp fails the test if the subject, x, does not match the regular
+// expression pattern.  The subject may be a string, []byte, error or
+// fmt.Stringer.
+func (a *Assertions) MatchesRegexp(pattern string) {
+	a.t.Helper()
+
+	if m, failed := a.evaluate(matchesRegexp(pattern)); failed {
+		formattedFailure(a, m.format, m.args...)
+	}
+}
+
+// EqualsIgnoringCase fails the test if the subject, x, is not equal to y under
+// Unicode case-folding.  The subject may be a string, []byte, error or
+// fmt.Stringer.
+func (a *Assertions) EqualsIgnoringCase(y string) {
+	a.t.Helper()
+
+	if m, failed := a.evaluate(equalsIgnoringCase(y)); failed {
+		formattedFailure(a, m.format, m.args...)
+	}
+}
+
+// EqualsIgnoringWhitespace fails the test if the subject, x, is not equal to y
+// once leading and trailing whitespace is removed and every other run of
+// whitespace is collapsed to a single space.  The subject may be a string,
+// []byte, error or fmt.Stringer.
+func (a *Assertions) EqualsIgnoringWhitespace(y string) {
+	a.t.Helper()
+
+	if m, failed := a.evaluate(equalsIgnoringWhitespace(y)); failed {
+		formattedFailure(a, m.format, m.args...)
+	}
+}
+
+// HasLineCount fails the test if the subject, x, does not have n lines.  A
+// trailing newline does not start a new line, and the empty string has no
+// lines.  The subject may be a string, []byte, error or fmt.Stringer.
+func (a *Assertions) HasLineCount(n int) {
+	a.t.Helper()
+
+	if m, failed := a.evaluate(hasLineCount(n)); failed {
+		formattedFailure(a, m.format, m.args...)
+	}
+}
+
+func startsWith(prefix string) predicate {
+	return withString(func(s string) outcome {
+		if !strings.HasPrefix(s, prefix) {
+			i := commonPrefixLength(s, prefix)
+			return failed("Expected %q to start with %q\n\n%s", s, prefix, prefixCaretFor(s, prefix, i))
+		}
+
+		return passed("Expected %q to not start with %q, but it did", s, prefix)
+	})
+}
+
+func endsWith(suffix string) predicate {
+	return withString(func(s string) outcome {
+		if !strings.HasSuffix(s, suffix) {
+			n := commonSuffixLength(s, suffix)
+			return failed("Expected %q to end with %q\n\n%s", s, suffix, suffixCaretFor(s, suffix, n))
+		}
+
+		return passed("Expected %q to not end with %q, but it did", s, suffix)
+	})
+}
+
+func containsSubstring(substr string) predicate {
+	return withString(func(s string) outcome {
+		if i := strings.Index(s, substr); i >= 0 {
+			return passed("Expected %q to not contain %q, but it did\n\n%s", s, substr, prefixCaretFor(s, s, i))
+		}
+
+		return failed("Expected %q to contain %q, but it did not", s, substr)
+	})
+}
+
+func matchesRegexp(pattern string) predicate {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return func(x interface{}) outcome {
+			return invalid("Expected a valid regular expression, but %q was invalid: %s", pattern, err)
+		}
+	}
+
+	return withString(func(s string) outcome {
+		if !re.MatchString(s) {
+			return failed("Expected %q to match regular expression %q, but it did not", s, pattern)
+		}
+
+		return passed("Expected %q to not match regular expression %q, but it did", s, pattern)
+	})
+}
+
+func equalsIgnoringCase(y string) predicate {
+	return withString(func(s string) outcome {
+		if !strings.EqualFold(s, y) {
+			i := commonFoldedPrefixLength(s, y)
+			return failed("Expected %q to be equal to %q ignoring case\n\n%s", s, y, prefixCaretFor(s, y, i))
+		}
+
+		return passed("Expected %q to not be equal to %q ignoring case, but it was", s, y)
+	})
+}
+
+func equalsIgnoringWhitespace(y string) predicate {
+	return withString(func(s string) outcome {
+		ns := collapseWhitespace(s)
+		ny := collapseWhitespace(y)
+
+		if ns != ny {
+			i := commonPrefixLength(ns, ny)
+			return failed("Expected %q to be equal to %q ignoring whitespace\n\n%s", s, y, prefixCaretFor(ns, ny, i))
+		}
+
+		return passed("Expected %q to not be equal to %q ignoring whitespace, but it was", s, y)
+	})
+}
+
+func hasLineCount(n int) predicate {
+	return withString(func(s string) outcome {
+		if l := lineCount(s); l != n {
+			return failed("Expected %q to have %v lines, but had %v", s, n, l)
+		}
+
+		return passed("Expected %q to not have %v lines, but it did", s, n)
+	})
+}
+
+// withString returns a predicate that applies p to the subject as a string.
+// The outcome is invalid if the subject cannot be treated as a string.
+func withString(p func(s string) outcome) predicate {
+	return func(x interface{}) outcome {
+		s, ok := baseStringValue(x)
+		if !ok {
+			return invalid("Expected a string, []byte, error or fmt.Stringer, but was not\nx: %s", typeNameFor(x))
+		}
+
+		return p(s)
+	}
+}
+
+func baseStringValue(x interface{}) (string, bool) {
+	switch v := x.(type) {
+	case string:
+		return v, true
+	case []byte:
+		return string(v), true
+	case error:
+		if baseNilTest(v) {
+			return "", false
+		}
+		return v.Error(), true
+	case fmt.Stringer:
+		if baseNilTest(v) {
+			return "", false
+		}
+		return v.String(), true
+	}
+
+	xv := reflect.ValueOf(x)
+	if xv.Kind() == reflect.String {
+		return xv.String(), true
+	}
+
+	return "", false
+}
+
+// commonPrefixLength returns the length in bytes of the longest common prefix
+// of a and b that ends on a rune boundary.
+func commonPrefixLength(a string, b string) int {
+	i := 0
+	for i < len(a) && i < len(b) {
+		ra, na := utf8.DecodeRuneInString(a[i:])
+		rb, _ := utf8.DecodeRuneInString(b[i:])
+		if ra != rb {
+			break
+		}
+		i += na
+	}
+
+	return i
+}
+
+// commonFoldedPrefixLength is like commonPrefixLength, but compares runes
+// under Unicode case-folding.  The result is a byte offset into a.
+func commonFoldedPrefixLength(a string, b string) int {
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		ra, na := utf8.DecodeRuneInString(a[i:])
+		rb, nb := utf8.DecodeRuneInString(b[j:])
+		if !strings.EqualFold(string(ra), string(rb)) {
+			break
+		}
+		i += na
+		j += nb
+	}
+
+	return i
+}
+
+// commonSuffixLength returns the length in bytes of the longest common suffix
+// of a and b that starts on a rune boundary.
+func commonSuffixLength(a string, b string) int {
+	n := 0
+	for n < len(a) && n < len(b) {
+		ra, na := utf8.DecodeLastRuneInString(a[:len(a)-n])
+		rb, _ := utf8.DecodeLastRuneInString(b[:len(b)-n])
+		if ra != rb {
+			break
+		}
+		n += na
+	}
+
+	return n
+}
+
+// prefixCaretFor renders x and y as quoted strings, one above the other, with
+// a caret under the first mismatching character, which is at byte offset i of
+// x.
+func prefixCaretFor(x string, y string, i int) string {
+	col := utf8.RuneCountInString(strconv.Quote(x[:i])) - 1
+
+	return fmt.Sprintf("x: %q\ny: %q\n   %v^", x, y, strings.Repeat(" ", col))
+}
+
+// suffixCaretFor renders x and y as quoted strings, right-aligned one above the
+// other, with a caret under the last mismatching character before their common
+// suffix of n bytes.
+func suffixCaretFor(x string, y string, n int) string {
+	qx := strconv.Quote(x)
+	qy := strconv.Quote(y)
+	wx := utf8.RuneCountInString(qx)
+	wy := utf8.RuneCountInString(qy)
+
+	width := wx
+	if wy > width {
+		width = wy
+	}
+
+	suffixWidth := utf8.RuneCountInString(strconv.Quote(x[len(x)-n:])) - 1
+	col := width - suffixWidth - 1
+
+	return fmt.Sprintf("x: %v%v\ny: %v%v\n   %v^", strings.Repeat(" ", width-wx), qx, strings.Repeat(" ", width-wy), qy, strings.Repeat(" ", col))
+}
+
+func collapseWhitespace(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
+
+func lineCount(s string) int {
+	if s == "" {
+		return 0
+	}
+
+	return strings.Count(strings.TrimSuffix(s, "\n"), "\n") + 1
+}
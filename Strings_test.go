@@ -0,0 +1,166 @@
+package test
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestStringSubjects(t *testing.T) {
+	testCases := []struct {
+		x  interface{}
+		ok bool
+	}{
+		{x: "Hello World", ok: true},
+		{x: []byte("Hello World"), ok: true},
+		{x: errors.New("Hello World"), ok: true},
+		{x: greeting{}, ok: true},
+		{x: 5, ok: false},
+		{x: nil, ok: false},
+		{x: error(nil), ok: false},
+	}
+
+	for _, testCase := range testCases {
+		recorder := NewRecorder()
+		That(recorder, testCase.x).StartsWith("Hello")
+
+		if !testCase.ok {
+			assertFailed(t, recorder)
+			assertFailureMessage(t, recorder, "Expected a string, []byte, error or fmt.Stringer, but was not")
+		} else {
+			assertPassed(t, recorder)
+			assertHelperCount(t, recorder, 2)
+		}
+	}
+}
+
+func TestStartsWith(t *testing.T) {
+	// Arrange.
+	recorder := NewRecorder()
+
+	// Act.
+	That(recorder, "hello world").StartsWith("help")
+
+	// Assert.
+	assertFailed(t, recorder)
+	assertFailureMessage(t, recorder, "Expected \"hello world\" to start with \"help\"\n\nx: \"hello world\"\ny: \"help\"\n       ^")
+}
+
+func TestEndsWith(t *testing.T) {
+	// Arrange.
+	pass := NewRecorder()
+	fail := NewRecorder()
+
+	// Act.
+	That(pass, "hello world").EndsWith("world")
+	That(fail, "hello world").EndsWith("bold")
+
+	// Assert.
+	assertPassed(t, pass)
+
+	assertFailed(t, fail)
+	assertFailureMessage(t, fail, "Expected \"hello world\" to end with \"bold\"\n\nx: \"hello world\"\ny:        \"bold\"\n            ^")
+}
+
+func TestContainsSubstring(t *testing.T) {
+	// Arrange.
+	pass := NewRecorder()
+	fail := NewRecorder()
+
+	// Act.
+	That(pass, []byte("hello world")).ContainsSubstring("o w")
+	That(fail, "hello world").ContainsSubstring("bye")
+
+	// Assert.
+	assertPassed(t, pass)
+
+	assertFailed(t, fail)
+	assertFailureMessage(t, fail, "Expected \"hello world\" to contain \"bye\", but it did not")
+}
+
+func TestMatchesRegexp(t *testing.T) {
+	testCases := []struct {
+		x       interface{}
+		pattern string
+		pass    bool
+		message string
+	}{
+		{x: "order-1234", pattern: `^order-\d+$`, pass: true},
+		{x: "order-abcd", pattern: `^order-\d+$`, pass: false, message: "Expected \"order-abcd\" to match regular expression \"^order-\\\\d+$\", but it did not"},
+		{x: "order-1234", pattern: `(`, pass: false, message: "Expected a valid regular expression"},
+	}
+
+	for _, testCase := range testCases {
+		recorder := NewRecorder()
+		That(recorder, testCase.x).MatchesRegexp(testCase.pattern)
+
+		if !testCase.pass {
+			assertFailed(t, recorder)
+			assertFailureMessage(t, recorder, testCase.message)
+		} else {
+			assertPassed(t, recorder)
+		}
+	}
+}
+
+func TestEqualsIgnoringCase(t *testing.T) {
+	// Arrange.
+	pass := NewRecorder()
+	fail := NewRecorder()
+
+	// Act.
+	That(pass, "Hello World").EqualsIgnoringCase("hELLO wORLD")
+	That(fail, "Hello World").EqualsIgnoringCase("hello there")
+
+	// Assert.
+	assertPassed(t, pass)
+
+	assertFailed(t, fail)
+	assertFailureMessage(t, fail, "ignoring case\n\nx: \"Hello World\"\ny: \"hello there\"\n          ^")
+}
+
+func TestEqualsIgnoringWhitespace(t *testing.T) {
+	// Arrange.
+	pass := NewRecorder()
+	fail := NewRecorder()
+
+	// Act.
+	That(pass, "  SELECT *\n\tFROM users ").EqualsIgnoringWhitespace("SELECT * FROM users")
+	That(fail, "SELECT *\nFROM users").EqualsIgnoringWhitespace("SELECT * FROM orders")
+
+	// Assert.
+	assertPassed(t, pass)
+
+	assertFailed(t, fail)
+	assertFailureMessage(t, fail, "ignoring whitespace\n\nx: \"SELECT * FROM users\"\ny: \"SELECT * FROM orders\"\n                  ^")
+}
+
+func TestHasLineCount(t *testing.T) {
+	testCases := []struct {
+		x     string
+		lines int
+	}{
+		{x: "", lines: 0},
+		{x: "one", lines: 1},
+		{x: "one\n", lines: 1},
+		{x: "one\ntwo", lines: 2},
+		{x: "one\n\nthree\n", lines: 3},
+	}
+
+	for _, testCase := range testCases {
+		pass := NewRecorder()
+		fail := NewRecorder()
+
+		That(pass, testCase.x).HasLineCount(testCase.lines)
+		That(fail, testCase.x).HasLineCount(testCase.lines + 1)
+
+		assertPassed(t, pass)
+		assertFailed(t, fail)
+		assertFailureMessage(t, fail, "to have %v lines, but had %v", testCase.lines+1, testCase.lines)
+	}
+}
+
+type greeting struct{}
+
+func (greeting) String() string {
+	return "Hello World"
+}
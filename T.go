@@ -5,5 +5,6 @@ package test
 type T interface {
 	Name() string
 	Helper()
+	Errorf(format string, args ...interface{})
 	Fatalf(format string, args ...interface{})
 }
@@ -0,0 +1,79 @@
+package test
+
+import (
+	"bytes"
+
+	"github.com/BurntSushi/toml"
+)
+
+// EqualsTOML fails the test if the subject, x, is not TOML semantically equal
+// to y.  It is EqualsDocument for TOML, so tables are compared regardless of
+// the order of their keys or whether they are written inline, numbers and
+// timestamps are compared by value, and comments and formatting are ignored.
+// y may be any value that is not text, which is encoded with
+// github.com/BurntSushi/toml.
+func (a *Assertions) EqualsTOML(y interface{}) {
+	a.t.Helper()
+
+	if m, failed := a.evaluate(equalsDocument(tomlFormat{}, y)); failed {
+		formattedFailure(a, m.format, m.args...)
+	}
+}
+
+// tomlFormat is the Format of TOML, decoded and encoded with
+// github.com/BurntSushi/toml.
+type tomlFormat struct{}
+
+func (tomlFormat) Name() string {
+	return "TOML"
+}
+
+// Parse decodes the TOML document text.
+func (tomlFormat) Parse(text string) ([]interface{}, error) {
+	var doc map[string]interface{}
+	if _, err := toml.Decode(text, &doc); err != nil {
+		return nil, err
+	}
+
+	return []interface{}{normalizeTOML(doc)}, nil
+}
+
+func (tomlFormat) Marshal(v interface{}) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	if err := toml.NewEncoder(buf).Encode(v); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// normalizeTOML converts arrays of tables, which are decoded as
+// []map[string]interface{}, into []interface{} so that they compare equal to
+// arrays of inline tables.
+func normalizeTOML(x interface{}) interface{} {
+	switch v := x.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			v[key] = normalizeTOML(value)
+		}
+
+		return v
+
+	case []map[string]interface{}:
+		elements := make([]interface{}, len(v))
+		for i, table := range v {
+			elements[i] = normalizeTOML(table)
+		}
+
+		return elements
+
+	case []interface{}:
+		for i, element := range v {
+			v[i] = normalizeTOML(element)
+		}
+
+		return v
+	}
+
+	return x
+}
@@ -0,0 +1,57 @@
+package test
+
+import "testing"
+
+func TestEqualsTOML(t *testing.T) {
+	type server struct {
+		Host  string   `toml:"host"`
+		Ports []int    `toml:"ports"`
+		Tags  []string `toml:"tags"`
+	}
+
+	type config struct {
+		Title  string `toml:"title"`
+		Server server `toml:"server"`
+	}
+
+	testCases := []struct {
+		x       interface{}
+		y       interface{}
+		pass    bool
+		message string
+	}{
+		{x: "title = \"app\"\n\n[server]\nhost = \"localhost\"\nports = [80, 443]\n", y: "# comment\nserver = { ports = [ 80, 443 ], host = 'localhost' }\ntitle = \"app\"", pass: true},
+		{x: "[[users]]\nname = \"a\"\n\n[[users]]\nname = \"b\"\n", y: "users = [{ name = \"a\" }, { name = \"b\" }]", pass: true},
+		{x: []byte("title = \"app\"\n[server]\nhost = \"h\"\nports = [1]\ntags = []\n"), y: config{Title: "app", Server: server{Host: "h", Ports: []int{1}, Tags: []string{}}}, pass: true},
+		{x: "timeout = 5", y: "timeout = 5.0", pass: true},
+		{x: "at = 2024-01-01T10:00:00+02:00", y: "at = 2024-01-01T08:00:00Z", pass: true},
+		{
+			x:       "[server]\nhost = \"localhost\"\nports = [80]\ntimeout = 5\n",
+			y:       "[server]\nhost = \"127.0.0.1\"\nports = [80, 443]\ntimeout = 6.0\n",
+			pass:    false,
+			message: "Expected TOML documents to be equal, but they differ\n\n[\"server\"][\"host\"]: \"localhost\" != \"127.0.0.1\"\n[\"server\"][\"ports\"][1]: <missing> != 443\n[\"server\"][\"timeout\"]: 5 != 6",
+		},
+		{
+			x:       "at = 2024-01-01T10:00:00+02:00",
+			y:       "at = 2024-01-01T10:00:00Z",
+			pass:    false,
+			message: "Expected TOML documents to be equal, but they differ\n\n[\"at\"]: \"2024-01-01T08:00:00Z\" != \"2024-01-01T10:00:00Z\"",
+		},
+		{x: "title = ", y: "title = \"app\"", pass: false, message: "Expected subject to be valid TOML, but it was not: toml: line 0 (last key \"title\"): unexpected EOF; expected value"},
+		{x: "title = \"app\"", y: 5, pass: false, message: "Expected comparator to be valid TOML, but it was not"},
+	}
+
+	for _, testCase := range testCases {
+		recorder := NewRecorder()
+		That(recorder, testCase.x).EqualsTOML(testCase.y)
+
+		if !testCase.pass {
+			assertFailed(t, recorder)
+			assertHelperCount(t, recorder, 3)
+			assertFailureMessage(t, recorder, testCase.message)
+		} else {
+			assertPassed(t, recorder)
+			assertHelperCount(t, recorder, 2)
+		}
+	}
+}
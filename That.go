@@ -2,7 +2,29 @@ package test
 
 // That returns a new *Assertions using the provided *testing.T and subject, x.
 func That(t T, x interface{}) *Assertions {
-	t.Helper()
+	a := assertionsFor(t, x)
+	a.t.Helper()
+
+	return a
+}
+
+// assertionsFor returns a new *Assertions about x that reports to t.  If t was
+// returned by Soft or Describe, the assertions report to the T it marks, and
+// take on its soft mode or scope.
+func assertionsFor(t T, x interface{}) *Assertions {
+	switch t := t.(type) {
+	case *SoftT:
+		a := assertionsFor(t.t, x)
+		a.soft = t
+
+		return a
+
+	case *describedT:
+		a := assertionsFor(t.t, x)
+		a.notes = &annotation{previous: a.notes, scope: t.scope}
+
+		return a
+	}
 
 	return &Assertions{
 		t: t,
@@ -0,0 +1,255 @@
+package test
+
+import (
+	"fmt"
+	"time"
+)
+
+// IsBefore fails the test if the subject, x, is not a time.Time before y.
+func (a *Assertions) IsBefore(y time.Time) {
+	a.t.Helper()
+
+	if m, failed := a.evaluate(before(y)); failed {
+		formattedFailure(a, m.format, m.args...)
+	}
+}
+
+// IsAfter fails the test if the subject, x, is not a time.Time after y.
+func (a *Assertions) IsAfter(y time.Time) {
+	a.t.Helper()
+
+	if m, failed := a.evaluate(after(y)); failed {
+		formattedFailure(a, m.format, m.args...)
+	}
+}
+
+// IsSameInstantAs fails the test if the subject, x, is not a time.Time
+// representing the same instant as y.  Unlike IsEqualTo, the location and
+// monotonic clock reading of each time are ignored.
+func (a *Assertions) IsSameInstantAs(y time.Time) {
+	a.t.Helper()
+
+	if m, failed := a.evaluate(sameInstantAs(y)); failed {
+		formattedFailure(a, m.format, m.args...)
+	}
+}
+
+// IsWithinDurationOf fails the test if the subject, x, is not within d of y.
+// The subject and y must either both be time.Time values or both be
+// time.Duration values, and d must not be negative.
+func (a *Assertions) IsWithinDurationOf(y interface{}, d time.Duration) {
+	a.t.Helper()
+
+	if m, failed := a.evaluate(withinDurationOf(y, d)); failed {
+		formattedFailure(a, m.format, m.args...)
+	}
+}
+
+// IsInLocation fails the test if the subject, x, is not a time.Time in loc.
+func (a *Assertions) IsInLocation(loc *time.Location) {
+	a.t.Helper()
+
+	if m, failed := a.evaluate(inLocation(loc)); failed {
+		formattedFailure(a, m.format, m.args...)
+	}
+}
+
+// IsTruncatedTo fails the test if the subject, x, is not a time.Time or
+// time.Duration that is an exact multiple of d, as determined by Truncate.
+// d must be positive.
+func (a *Assertions) IsTruncatedTo(d time.Duration) {
+	a.t.Helper()
+
+	if m, failed := a.evaluate(truncatedTo(d)); failed {
+		formattedFailure(a, m.format, m.args...)
+	}
+}
+
+// IsShorterThan fails the test if the subject, x, is not a time.Duration
+// shorter than d.
+func (a *Assertions) IsShorterThan(d time.Duration) {
+	a.t.Helper()
+
+	if m, failed := a.evaluate(shorterThan(d)); failed {
+		formattedFailure(a, m.format, m.args...)
+	}
+}
+
+// IsLongerThan fails the test if the subject, x, is not a time.Duration
+// longer than d.
+func (a *Assertions) IsLongerThan(d time.Duration) {
+	a.t.Helper()
+
+	if m, failed := a.evaluate(longerThan(d)); failed {
+		formattedFailure(a, m.format, m.args...)
+	}
+}
+
+func before(y time.Time) predicate {
+	return withTime(func(xt time.Time) outcome {
+		if !xt.Before(y) {
+			return failed("Expected %s to be before %s\ndifference: %s", formatTime(xt), formatTime(y), signedDuration(xt.Sub(y)))
+		}
+
+		return passed("Expected %s to not be before %s\ndifference: %s", formatTime(xt), formatTime(y), signedDuration(xt.Sub(y)))
+	})
+}
+
+func after(y time.Time) predicate {
+	return withTime(func(xt time.Time) outcome {
+		if !xt.After(y) {
+			return failed("Expected %s to be after %s\ndifference: %s", formatTime(xt), formatTime(y), signedDuration(xt.Sub(y)))
+		}
+
+		return passed("Expected %s to not be after %s\ndifference: %s", formatTime(xt), formatTime(y), signedDuration(xt.Sub(y)))
+	})
+}
+
+func sameInstantAs(y time.Time) predicate {
+	return withTime(func(xt time.Time) outcome {
+		if !xt.Equal(y) {
+			return failed("Expected %s to be the same instant as %s\ndifference: %s", formatTime(xt), formatTime(y), signedDuration(xt.Sub(y)))
+		}
+
+		return passed("Expected %s to not be the same instant as %s, but it was", formatTime(xt), formatTime(y))
+	})
+}
+
+func withinDurationOf(y interface{}, d time.Duration) predicate {
+	return func(x interface{}) outcome {
+		if d < 0 {
+			return invalid("Expected a non-negative tolerance, but was %s", d)
+		}
+
+		diff, ok := baseTemporalDifference(x, y)
+		if !ok {
+			return invalid("Expected two time.Time or two time.Duration values\nx: %s\ny: %s", typeNameFor(x), typeNameFor(y))
+		}
+
+		if diff < -d || diff > d {
+			return failed("Expected %s to be within %s of %s\ndifference: %s", formatTemporal(x), d, formatTemporal(y), signedDuration(diff))
+		}
+
+		return passed("Expected %s to not be within %s of %s\ndifference: %s", formatTemporal(x), d, formatTemporal(y), signedDuration(diff))
+	}
+}
+
+func inLocation(loc *time.Location) predicate {
+	return withTime(func(xt time.Time) outcome {
+		if xt.Location().String() != loc.String() {
+			return failed("Expected %s to be in location %s, but was in %s", formatTime(xt), loc, xt.Location())
+		}
+
+		return passed("Expected %s to not be in location %s, but it was", formatTime(xt), loc)
+	})
+}
+
+func truncatedTo(d time.Duration) predicate {
+	return func(x interface{}) outcome {
+		if d <= 0 {
+			return invalid("Expected a positive duration to truncate to, but was %s", d)
+		}
+
+		var remainder time.Duration
+		switch xv := x.(type) {
+		case time.Time:
+			remainder = xv.Sub(xv.Truncate(d))
+		case time.Duration:
+			remainder = xv - xv.Truncate(d)
+		default:
+			return invalid("Expected a time.Time or time.Duration, but was not\nx: %s", typeNameFor(x))
+		}
+
+		if remainder != 0 {
+			return failed("Expected %s to be truncated to %s, but had a remainder of %s", formatTemporal(x), d, remainder)
+		}
+
+		return passed("Expected %s to not be truncated to %s, but it was", formatTemporal(x), d)
+	}
+}
+
+func shorterThan(d time.Duration) predicate {
+	return withDuration(func(xd time.Duration) outcome {
+		if xd >= d {
+			return failed("Expected %s to be shorter than %s\ndifference: %s", xd, d, signedDuration(xd-d))
+		}
+
+		return passed("Expected %s to not be shorter than %s\ndifference: %s", xd, d, signedDuration(xd-d))
+	})
+}
+
+func longerThan(d time.Duration) predicate {
+	return withDuration(func(xd time.Duration) outcome {
+		if xd <= d {
+			return failed("Expected %s to be longer than %s\ndifference: %s", xd, d, signedDuration(xd-d))
+		}
+
+		return passed("Expected %s to not be longer than %s\ndifference: %s", xd, d, signedDuration(xd-d))
+	})
+}
+
+// withTime returns a predicate that applies p to the subject as a time.Time.
+// The outcome is invalid if the subject is not a time.Time.
+func withTime(p func(xt time.Time) outcome) predicate {
+	return func(x interface{}) outcome {
+		xt, ok := baseTimeValue(x)
+		if !ok {
+			return invalid("Expected a time.Time, but was not\nx: %s", typeNameFor(x))
+		}
+
+		return p(xt)
+	}
+}
+
+// withDuration returns a predicate that applies p to the subject as a
+// time.Duration.  The outcome is invalid if the subject is not a
+// time.Duration.
+func withDuration(p func(xd time.Duration) outcome) predicate {
+	return func(x interface{}) outcome {
+		xd, ok := x.(time.Duration)
+		if !ok {
+			return invalid("Expected a time.Duration, but was not\nx: %s", typeNameFor(x))
+		}
+
+		return p(xd)
+	}
+}
+
+// baseTemporalDifference returns x - y when both are time.Time values or both
+// are time.Duration values.
+func baseTemporalDifference(x interface{}, y interface{}) (time.Duration, bool) {
+	switch xv := x.(type) {
+	case time.Time:
+		if yv, ok := y.(time.Time); ok {
+			return xv.Sub(yv), true
+		}
+	case time.Duration:
+		if yv, ok := y.(time.Duration); ok {
+			return xv - yv, true
+		}
+	}
+
+	return 0, false
+}
+
+func formatTime(t time.Time) string {
+	return t.Format(time.RFC3339Nano)
+}
+
+func formatTemporal(x interface{}) string {
+	if t, ok := x.(time.Time); ok {
+		return formatTime(t)
+	}
+
+	return fmt.Sprintf("%v", x)
+}
+
+// signedDuration formats d with an explicit sign, so that it reads as the
+// amount by which the subject is ahead of (+) or behind (-) the comparator.
+func signedDuration(d time.Duration) string {
+	if d >= 0 {
+		return "+" + d.String()
+	}
+
+	return d.String()
+}
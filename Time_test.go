@@ -0,0 +1,178 @@
+package test
+
+import (
+	"testing"
+	"time"
+)
+
+func TestIsBeforeAndIsAfter(t *testing.T) {
+	base := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		x      time.Time
+		y      time.Time
+		before bool
+		after  bool
+	}{
+		{x: base, y: base.Add(time.Second), before: true},
+		{x: base.Add(time.Second), y: base, after: true},
+		{x: base, y: base},
+		{x: base.In(time.FixedZone("X", 3600)), y: base},
+	}
+
+	for _, testCase := range testCases {
+		before := NewRecorder()
+		after := NewRecorder()
+
+		That(before, testCase.x).IsBefore(testCase.y)
+		That(after, testCase.x).IsAfter(testCase.y)
+
+		assertOutcome(t, before, testCase.before)
+		assertOutcome(t, after, testCase.after)
+	}
+}
+
+func TestIsBeforeReportsDifference(t *testing.T) {
+	// Arrange.
+	x := time.Date(2020, 1, 1, 12, 0, 1, 500000000, time.UTC)
+	y := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	// Act.
+	recorder := NewRecorder()
+
+	That(recorder, x).IsBefore(y)
+
+	// Assert.
+	assertFailed(t, recorder)
+	assertHelperCount(t, recorder, 3)
+	assertFailureMessage(t, recorder, "Expected 2020-01-01T12:00:01.5Z to be before 2020-01-01T12:00:00Z\ndifference: +1.5s")
+}
+
+func TestIsSameInstantAs(t *testing.T) {
+	// Arrange.
+	now := time.Now()
+	stripped := now.Round(0).In(time.FixedZone("X", -7200))
+
+	// Act.
+	equal := NewRecorder()
+	sameInstant := NewRecorder()
+	different := NewRecorder()
+
+	That(equal, now).IsEqualTo(stripped)
+	That(sameInstant, now).IsSameInstantAs(stripped)
+	That(different, now).IsSameInstantAs(now.Add(-time.Minute))
+
+	// Assert.
+	assertFailed(t, equal)
+	assertPassed(t, sameInstant)
+	assertFailed(t, different)
+	assertFailureMessage(t, different, "difference: +1m0s")
+}
+
+func TestIsWithinDurationOf(t *testing.T) {
+	base := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		x       interface{}
+		y       interface{}
+		d       time.Duration
+		pass    bool
+		message string
+	}{
+		{x: base, y: base.Add(time.Second), d: time.Second, pass: true},
+		{x: base, y: base.Add(-2 * time.Second), d: time.Second, pass: false, message: "to be within 1s of 2020-01-01T11:59:58Z\ndifference: +2s"},
+		{x: 900 * time.Millisecond, y: time.Second, d: 100 * time.Millisecond, pass: true},
+		{x: 800 * time.Millisecond, y: time.Second, d: 100 * time.Millisecond, pass: false, message: "Expected 800ms to be within 100ms of 1s\ndifference: -200ms"},
+		{x: base, y: time.Second, d: time.Second, pass: false, message: "Expected two time.Time or two time.Duration values\nx: time.Time\ny: time.Duration"},
+		{x: base, y: base, d: 0, pass: true},
+		{x: base, y: base, d: -time.Second, pass: false, message: "Expected a non-negative tolerance, but was -1s"},
+	}
+
+	for _, testCase := range testCases {
+		recorder := NewRecorder()
+		That(recorder, testCase.x).IsWithinDurationOf(testCase.y, testCase.d)
+
+		if !testCase.pass {
+			assertFailed(t, recorder)
+			assertFailureMessage(t, recorder, testCase.message)
+		} else {
+			assertPassed(t, recorder)
+		}
+	}
+}
+
+func TestIsInLocation(t *testing.T) {
+	// Arrange.
+	x := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	// Act.
+	pass := NewRecorder()
+	fail := NewRecorder()
+
+	That(pass, x).IsInLocation(time.UTC)
+	That(fail, x).IsInLocation(time.FixedZone("AEST", 10*3600))
+
+	// Assert.
+	assertPassed(t, pass)
+	assertFailed(t, fail)
+	assertFailureMessage(t, fail, "to be in location AEST, but was in UTC")
+}
+
+func TestIsTruncatedTo(t *testing.T) {
+	testCases := []struct {
+		x    interface{}
+		d    time.Duration
+		pass bool
+	}{
+		{x: time.Date(2020, 1, 1, 12, 30, 0, 0, time.UTC), d: time.Minute, pass: true},
+		{x: time.Date(2020, 1, 1, 12, 30, 0, 1, time.UTC), d: time.Minute, pass: false},
+		{x: 3 * time.Second, d: time.Second, pass: true},
+		{x: 3500 * time.Millisecond, d: time.Second, pass: false},
+		{x: 5, d: time.Second, pass: false},
+		{x: 3 * time.Second, d: 0, pass: false},
+		{x: 3 * time.Second, d: -time.Second, pass: false},
+	}
+
+	for _, testCase := range testCases {
+		recorder := NewRecorder()
+		That(recorder, testCase.x).IsTruncatedTo(testCase.d)
+
+		assertOutcome(t, recorder, testCase.pass)
+	}
+}
+
+func TestTimeAssertionsRejectInvalidDurations(t *testing.T) {
+	// Arrange.
+	truncated := NewRecorder()
+	within := NewRecorder()
+
+	// Act.
+	That(truncated, 3*time.Second).Not().IsTruncatedTo(0)
+	That(within, time.Second).Not().IsWithinDurationOf(time.Second, -time.Second)
+
+	// Assert.
+	assertFailed(t, truncated)
+	assertFailureMessage(t, truncated, "Expected a positive duration to truncate to, but was 0s")
+
+	assertFailed(t, within)
+	assertFailureMessage(t, within, "Expected a non-negative tolerance, but was -1s")
+}
+
+func TestDurationComparisons(t *testing.T) {
+	// Arrange.
+	shorter := NewRecorder()
+	longer := NewRecorder()
+	notDuration := NewRecorder()
+
+	// Act.
+	That(shorter, 2*time.Second).IsShorterThan(time.Second)
+	That(longer, 2*time.Second).IsLongerThan(time.Second)
+	That(notDuration, int64(2)).IsLongerThan(time.Second)
+
+	// Assert.
+	assertFailed(t, shorter)
+	assertFailureMessage(t, shorter, "Expected 2s to be shorter than 1s\ndifference: +1s")
+	assertPassed(t, longer)
+	assertFailed(t, notDuration)
+	assertFailureMessage(t, notDuration, "Expected a time.Duration, but was not\nx: int64")
+}
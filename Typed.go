@@ -0,0 +1,347 @@
+package test
+
+// Ordered is a constraint permitting any type that supports the <, <=, >=
+// and > operators.
+type Ordered interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64 |
+		~string
+}
+
+// ComparableAssertions defines type-safe assertions about a comparable
+// subject, x.  The untyped assertions on *Assertions remain available.
+type ComparableAssertions[V comparable] struct {
+	*Assertions
+	v V
+}
+
+// Is returns a new *ComparableAssertions using the provided *testing.T and
+// subject, x.  Comparisons against values of a different type than x are
+// rejected at compile time.
+func Is[V comparable](t T, x V) *ComparableAssertions[V] {
+	a := assertionsFor(t, x)
+	a.t.Helper()
+
+	return &ComparableAssertions[V]{
+		Assertions: a,
+		v:          x,
+	}
+}
+
+// Not returns a copy of a whose assertions fail if and only if they would have
+// passed.  Calling Not twice restores the original behaviour.
+func (a *ComparableAssertions[V]) Not() *ComparableAssertions[V] {
+	return &ComparableAssertions[V]{Assertions: a.Assertions.Not(), v: a.v}
+}
+
+// IsEqualTo fails the test if y is not equal to the subject, x.
+func (a *ComparableAssertions[V]) IsEqualTo(y V) {
+	a.t.Helper()
+
+	a.Assertions.IsEqualTo(y)
+}
+
+// IsNotEqualTo fails the test if y is equal to the subject, x.
+func (a *ComparableAssertions[V]) IsNotEqualTo(y V) {
+	a.t.Helper()
+
+	a.Assertions.IsNotEqualTo(y)
+}
+
+// IsOneOf fails the test if the subject, x, is not equal to any of ys.
+func (a *ComparableAssertions[V]) IsOneOf(ys ...V) {
+	a.t.Helper()
+
+	if m, failed := a.evaluate(oneOf(a.v, ys)); failed {
+		formattedFailure(a.Assertions, m.format, m.args...)
+	}
+}
+
+// OrderedAssertions defines type-safe assertions about an ordered subject, x.
+type OrderedAssertions[V Ordered] struct {
+	*ComparableAssertions[V]
+}
+
+// Compare returns a new *OrderedAssertions using the provided *testing.T and
+// subject, x.  Unlike the untyped ordering assertions, strings are supported.
+func Compare[V Ordered](t T, x V) *OrderedAssertions[V] {
+	a := Is(t, x)
+	a.t.Helper()
+
+	return &OrderedAssertions[V]{
+		ComparableAssertions: a,
+	}
+}
+
+// Not returns a copy of a whose assertions fail if and only if they would have
+// passed.  Calling Not twice restores the original behaviour.
+func (a *OrderedAssertions[V]) Not() *OrderedAssertions[V] {
+	return &OrderedAssertions[V]{ComparableAssertions: a.ComparableAssertions.Not()}
+}
+
+// IsGreaterThan fails the test if the subject, x, is not greater than y.
+func (a *OrderedAssertions[V]) IsGreaterThan(y V) {
+	a.t.Helper()
+
+	if m, failed := a.evaluate(orderedAs(a.v, y, "greater than", func(x V, y V) bool { return x > y })); failed {
+		formattedFailure(a.Assertions, m.format, m.args...)
+	}
+}
+
+// IsGreaterThanOrEqualTo fails the test if the subject, x, is not greater than
+// or equal to y.
+func (a *OrderedAssertions[V]) IsGreaterThanOrEqualTo(y V) {
+	a.t.Helper()
+
+	if m, failed := a.evaluate(orderedAs(a.v, y, "greater than or equal to", func(x V, y V) bool { return x >= y })); failed {
+		formattedFailure(a.Assertions, m.format, m.args...)
+	}
+}
+
+// IsLessThan fails the test if the subject, x, is not less than y.
+func (a *OrderedAssertions[V]) IsLessThan(y V) {
+	a.t.Helper()
+
+	if m, failed := a.evaluate(orderedAs(a.v, y, "less than", func(x V, y V) bool { return x < y })); failed {
+		formattedFailure(a.Assertions, m.format, m.args...)
+	}
+}
+
+// IsLessThanOrEqualTo fails the test if the subject, x, is not less than or
+// equal to y.
+func (a *OrderedAssertions[V]) IsLessThanOrEqualTo(y V) {
+	a.t.Helper()
+
+	if m, failed := a.evaluate(orderedAs(a.v, y, "less than or equal to", func(x V, y V) bool { return x <= y })); failed {
+		formattedFailure(a.Assertions, m.format, m.args...)
+	}
+}
+
+// IsBetween fails the test if the subject, x, is not between lo and hi
+// inclusive.
+func (a *OrderedAssertions[V]) IsBetween(lo V, hi V) {
+	a.t.Helper()
+
+	if m, failed := a.evaluate(orderedBetween(a.v, lo, hi)); failed {
+		formattedFailure(a.Assertions, m.format, m.args...)
+	}
+}
+
+// SliceAssertions defines type-safe assertions about a slice subject, x.
+type SliceAssertions[E comparable] struct {
+	*Assertions
+	xs []E
+}
+
+// Slice returns a new *SliceAssertions using the provided *testing.T and
+// subject, xs.
+func Slice[E comparable](t T, xs []E) *SliceAssertions[E] {
+	a := assertionsFor(t, xs)
+	a.t.Helper()
+
+	return &SliceAssertions[E]{
+		Assertions: a,
+		xs:         xs,
+	}
+}
+
+// Not returns a copy of a whose assertions fail if and only if they would have
+// passed.  Calling Not twice restores the original behaviour.
+func (a *SliceAssertions[E]) Not() *SliceAssertions[E] {
+	return &SliceAssertions[E]{Assertions: a.Assertions.Not(), xs: a.xs}
+}
+
+// Contains fails the test if the subject, xs, does not contain e.
+func (a *SliceAssertions[E]) Contains(e E) {
+	a.t.Helper()
+
+	if m, failed := a.evaluate(containsElement(a.xs, e)); failed {
+		formattedFailure(a.Assertions, m.format, m.args...)
+	}
+}
+
+// DoesNotContain fails the test if the subject, xs, contains e.
+func (a *SliceAssertions[E]) DoesNotContain(e E) {
+	a.t.Helper()
+
+	if m, failed := a.Not().evaluate(containsElement(a.xs, e)); failed {
+		formattedFailure(a.Assertions, m.format, m.args...)
+	}
+}
+
+// HasEquivalentSequenceTo fails the test if the subject, xs, does not have the
+// exact same sequence of values as ys.
+func (a *SliceAssertions[E]) HasEquivalentSequenceTo(ys []E) {
+	a.t.Helper()
+
+	a.Assertions.HasEquivalentSequenceTo(ys)
+}
+
+// HasSameElementsAs fails the test if the subject, xs, and ys do not contain
+// the same elements with the same multiplicities, regardless of order.
+func (a *SliceAssertions[E]) HasSameElementsAs(ys []E) {
+	a.t.Helper()
+
+	a.Assertions.HasSameElementsAs(ys)
+}
+
+// MapAssertions defines type-safe assertions about a map subject, m.
+type MapAssertions[K comparable, V any] struct {
+	*Assertions
+	m map[K]V
+}
+
+// Map returns a new *MapAssertions using the provided *testing.T and subject,
+// m.
+func Map[K comparable, V any](t T, m map[K]V) *MapAssertions[K, V] {
+	a := assertionsFor(t, m)
+	a.t.Helper()
+
+	return &MapAssertions[K, V]{
+		Assertions: a,
+		m:          m,
+	}
+}
+
+// Not returns a copy of a whose assertions fail if and only if they would have
+// passed.  Calling Not twice restores the original behaviour.
+func (a *MapAssertions[K, V]) Not() *MapAssertions[K, V] {
+	return &MapAssertions[K, V]{Assertions: a.Assertions.Not(), m: a.m}
+}
+
+// HasKey fails the test if the subject, m, does not have the key k.
+func (a *MapAssertions[K, V]) HasKey(k K) {
+	a.t.Helper()
+
+	if m, failed := a.evaluate(hasTypedKey(a.m, k)); failed {
+		formattedFailure(a.Assertions, m.format, m.args...)
+	}
+}
+
+// DoesNotHaveKey fails the test if the subject, m, has the key k.
+func (a *MapAssertions[K, V]) DoesNotHaveKey(k K) {
+	a.t.Helper()
+
+	if m, failed := a.Not().evaluate(hasTypedKey(a.m, k)); failed {
+		formattedFailure(a.Assertions, m.format, m.args...)
+	}
+}
+
+// HasKeys fails the test if the subject, m, does not have every one of ks.
+func (a *MapAssertions[K, V]) HasKeys(ks ...K) {
+	a.t.Helper()
+
+	if m, failed := a.evaluate(hasTypedKeys(a.m, ks)); failed {
+		formattedFailure(a.Assertions, m.format, m.args...)
+	}
+}
+
+// HasEntry fails the test if the subject, m, does not have the key k with a
+// value deeply equal to v.
+func (a *MapAssertions[K, V]) HasEntry(k K, v V) {
+	a.t.Helper()
+
+	a.Assertions.HasEntry(k, v)
+}
+
+// IsSubsetOf fails the test if any entry of the subject, m, is missing from
+// other or has a value in other that is not deeply equal.
+func (a *MapAssertions[K, V]) IsSubsetOf(other map[K]V) {
+	a.t.Helper()
+
+	a.Assertions.IsSubsetOf(other)
+}
+
+// IsSupersetOf fails the test if any entry of other is missing from the
+// subject, m, or has a value in m that is not deeply equal.
+func (a *MapAssertions[K, V]) IsSupersetOf(other map[K]V) {
+	a.t.Helper()
+
+	a.Assertions.IsSupersetOf(other)
+}
+
+// oneOf returns a predicate that passes if the subject, v, is equal to one of
+// ys.  Like the other typed predicates, it uses the typed subject rather than
+// the untyped one that it is passed, which is a nil interface{} rather than a
+// nil V when V is an interface type.
+func oneOf[V comparable](v V, ys []V) predicate {
+	return func(interface{}) outcome {
+		for _, y := range ys {
+			if v == y {
+				return passed("Expected %v to not be one of %v, but it was", v, ys)
+			}
+		}
+
+		return failed("Expected %v to be one of %v", v, ys)
+	}
+}
+
+// orderedAs returns a predicate that passes if accept returns true for the
+// subject, v, and y, using the native operators of V.
+func orderedAs[V Ordered](v V, y V, relation string, accept func(x V, y V) bool) predicate {
+	return func(interface{}) outcome {
+		if !accept(v, y) {
+			return failed("Expected %v to be %s %v", v, relation, y)
+		}
+
+		return passed("Expected %v to not be %s %v", v, relation, y)
+	}
+}
+
+func orderedBetween[V Ordered](v V, lo V, hi V) predicate {
+	return func(interface{}) outcome {
+		if !(v >= lo && v <= hi) {
+			return failed("Expected %v to be between %v and %v inclusive", v, lo, hi)
+		}
+
+		return passed("Expected %v to not be between %v and %v inclusive", v, lo, hi)
+	}
+}
+
+func containsElement[E comparable](xs []E, e E) predicate {
+	return func(interface{}) outcome {
+		if i := indexOf(xs, e); i >= 0 {
+			return passed("Expected %v to not contain %v, but it did at index %v", xs, e, i)
+		}
+
+		return failed("Expected %v to contain %v, but it did not", xs, e)
+	}
+}
+
+func hasTypedKey[K comparable, V any](m map[K]V, k K) predicate {
+	return func(interface{}) outcome {
+		if _, ok := m[k]; !ok {
+			return failed("Expected %v to have key %v, but it did not", m, k)
+		}
+
+		return passed("Expected %v to not have key %v, but it did", m, k)
+	}
+}
+
+func hasTypedKeys[K comparable, V any](m map[K]V, ks []K) predicate {
+	return func(interface{}) outcome {
+		var missing []K
+		for _, k := range ks {
+			if _, ok := m[k]; !ok {
+				missing = append(missing, k)
+			}
+		}
+
+		if len(missing) > 0 {
+			return failed("Expected %v to have keys %v, but it was missing %v", m, ks, missing)
+		}
+
+		return passed("Expected %v to not have keys %v, but it did", m, ks)
+	}
+}
+
+func indexOf[E comparable](xs []E, e E) int {
+	for i, x := range xs {
+		if x == e {
+			return i
+		}
+	}
+
+	return -1
+}
@@ -0,0 +1,32 @@
+//go:build go1.20
+
+// Interface types only satisfy comparable from Go 1.20, so this file requires
+// it regardless of the version of the module.
+
+package test
+
+import (
+	"io"
+	"testing"
+)
+
+func TestIsWithNilInterface(t *testing.T) {
+	// Arrange.
+	oneOf := NewRecorder()
+	notOneOf := NewRecorder()
+	contains := NewRecorder()
+	hasKey := NewRecorder()
+
+	// Act.
+	Is[error](oneOf, nil).IsOneOf(io.EOF)
+	Is[error](notOneOf, nil).Not().IsOneOf(io.EOF)
+	Slice(contains, []error{nil}).Contains(nil)
+	Map(hasKey, map[error]int{nil: 1}).HasKey(nil)
+
+	// Assert.
+	assertFailed(t, oneOf)
+	assertFailureMessage(t, oneOf, "Expected nil to be one of []error{")
+	assertPassed(t, notOneOf)
+	assertPassed(t, contains)
+	assertPassed(t, hasKey)
+}
@@ -0,0 +1,200 @@
+package test
+
+import "testing"
+
+func TestIs(t *testing.T) {
+	testCases := []struct {
+		x    int16
+		y    int16
+		pass bool
+	}{
+		{x: 4, y: 4, pass: true},
+		{x: 4, y: 5, pass: false},
+	}
+
+	for _, testCase := range testCases {
+		equal := NewRecorder()
+		notEqual := NewRecorder()
+
+		Is(equal, testCase.x).IsEqualTo(testCase.y)
+		Is(notEqual, testCase.x).IsNotEqualTo(testCase.y)
+
+		if testCase.pass {
+			assertPassed(t, equal)
+			assertHelperCount(t, equal, 3)
+			assertFailed(t, notEqual)
+		} else {
+			assertFailed(t, equal)
+			assertHelperCount(t, equal, 4)
+			assertFailureMessage(t, equal, "Expected %v to be equal to %v\nx: int16\ny: int16", testCase.x, testCase.y)
+			assertPassed(t, notEqual)
+		}
+	}
+}
+
+func TestIsOneOf(t *testing.T) {
+	// Arrange.
+	pass := NewRecorder()
+	fail := NewRecorder()
+
+	// Act.
+	Is(pass, "b").IsOneOf("a", "b")
+	Is(fail, "c").IsOneOf("a", "b")
+
+	// Assert.
+	assertPassed(t, pass)
+	assertFailed(t, fail)
+	assertFailureMessage(t, fail, "Expected \"c\" to be one of []string{\"a\", \"b\"}")
+}
+
+func TestIsKeepsUntypedAssertions(t *testing.T) {
+	// Arrange.
+	recorder := NewRecorder()
+
+	// Act.
+	Is(recorder, 4).IsPositive()
+
+	// Assert.
+	assertPassed(t, recorder)
+}
+
+func TestCompare(t *testing.T) {
+	testCases := []struct {
+		x   string
+		y   string
+		gt  bool
+		gte bool
+		lt  bool
+		lte bool
+	}{
+		{x: "b", y: "a", gt: true, gte: true},
+		{x: "a", y: "a", gte: true, lte: true},
+		{x: "a", y: "b", lt: true, lte: true},
+	}
+
+	for _, testCase := range testCases {
+		rgt := NewRecorder()
+		rgte := NewRecorder()
+		rlt := NewRecorder()
+		rlte := NewRecorder()
+
+		Compare(rgt, testCase.x).IsGreaterThan(testCase.y)
+		Compare(rgte, testCase.x).IsGreaterThanOrEqualTo(testCase.y)
+		Compare(rlt, testCase.x).IsLessThan(testCase.y)
+		Compare(rlte, testCase.x).IsLessThanOrEqualTo(testCase.y)
+
+		assertOutcome(t, rgt, testCase.gt)
+		assertOutcome(t, rgte, testCase.gte)
+		assertOutcome(t, rlt, testCase.lt)
+		assertOutcome(t, rlte, testCase.lte)
+	}
+}
+
+func TestCompareIsBetween(t *testing.T) {
+	type celsius float64
+
+	// Arrange.
+	pass := NewRecorder()
+	fail := NewRecorder()
+
+	// Act.
+	Compare(pass, celsius(21.5)).IsBetween(18, 24)
+	Compare(fail, celsius(30)).IsBetween(18, 24)
+
+	// Assert.
+	assertPassed(t, pass)
+	assertFailed(t, fail)
+	assertFailureMessage(t, fail, "Expected test.celsius(30) to be between test.celsius(18) and test.celsius(24) inclusive")
+}
+
+func TestTypedNot(t *testing.T) {
+	// Arrange.
+	oneOf := NewRecorder()
+	between := NewRecorder()
+	contains := NewRecorder()
+	hasKey := NewRecorder()
+
+	// Act.
+	Is(oneOf, "b").Not().IsOneOf("a", "b")
+	Compare(between, 30).Not().IsBetween(18, 24)
+	Slice(contains, []int{1, 2}).Not().Contains(2)
+	Map(hasKey, map[string]int{"a": 1}).Not().HasKey("b")
+
+	// Assert.
+	assertFailed(t, oneOf)
+	assertFailureMessage(t, oneOf, "Expected \"b\" to not be one of []string{\"a\", \"b\"}, but it was")
+	assertPassed(t, between)
+	assertFailed(t, contains)
+	assertFailureMessage(t, contains, "Expected []int{1, 2} to not contain 2, but it did at index 1")
+	assertPassed(t, hasKey)
+}
+
+func TestSlice(t *testing.T) {
+	// Arrange.
+	xs := []string{"a", "b", "c"}
+
+	// Act.
+	contains := NewRecorder()
+	doesNotContain := NewRecorder()
+	sequence := NewRecorder()
+	elements := NewRecorder()
+
+	Slice(contains, xs).Contains("d")
+	Slice(doesNotContain, xs).DoesNotContain("b")
+	Slice(sequence, xs).HasEquivalentSequenceTo([]string{"a", "b", "c"})
+	Slice(elements, xs).HasSameElementsAs([]string{"c", "b", "a"})
+
+	// Assert.
+	assertFailed(t, contains)
+	assertFailureMessage(t, contains, "Expected []string{\"a\", \"b\", \"c\"} to contain \"d\", but it did not")
+	assertFailed(t, doesNotContain)
+	assertFailureMessage(t, doesNotContain, "Expected []string{\"a\", \"b\", \"c\"} to not contain \"b\", but it did at index 1")
+	assertPassed(t, sequence)
+	assertPassed(t, elements)
+}
+
+func TestMap(t *testing.T) {
+	// Arrange.
+	m := map[string][]int{"a": {1}, "b": {2}}
+
+	// Act.
+	hasKey := NewRecorder()
+	doesNotHaveKey := NewRecorder()
+	hasKeys := NewRecorder()
+	hasEntry := NewRecorder()
+	subset := NewRecorder()
+	superset := NewRecorder()
+
+	Map(hasKey, m).HasKey("a")
+	Map(doesNotHaveKey, m).DoesNotHaveKey("a")
+	Map(hasKeys, m).HasKeys("a", "c")
+	Map(hasEntry, m).HasEntry("b", []int{2})
+	Map(subset, m).IsSubsetOf(map[string][]int{"a": {1}, "b": {2}, "c": {3}})
+	Map(superset, m).IsSupersetOf(map[string][]int{"a": {2}})
+
+	// Assert.
+	assertPassed(t, hasKey)
+	assertFailed(t, doesNotHaveKey)
+	assertFailed(t, hasKeys)
+	assertFailureMessage(t, hasKeys, "but it was missing []string{\"c\"}")
+	assertPassed(t, hasEntry)
+	assertPassed(t, subset)
+	assertFailed(t, superset)
+}
+
+func TestMapWithInterfaceValues(t *testing.T) {
+	// Arrange.
+	m := map[string]any{"a": "b", "n": 1}
+
+	// Act.
+	pass := NewRecorder()
+	fail := NewRecorder()
+
+	Map(pass, m).HasEntry("a", "b")
+	Map(fail, m).HasEntry("n", 2)
+
+	// Assert.
+	assertPassed(t, pass)
+	assertFailed(t, fail)
+	assertFailureMessage(t, fail, "[\"n\"]: 1 != 2")
+}
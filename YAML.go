@@ -0,0 +1,51 @@
+package test
+
+import (
+	"io"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// EqualsYAML fails the test if the subject, x, is not YAML semantically equal
+// to y.  It is EqualsDocument for YAML, so mappings are compared regardless of
+// the order of their keys, numbers and timestamps are compared by value, and
+// comments, quoting styles and anchors are ignored.  y may be any value that
+// is not text, which is marshalled with gopkg.in/yaml.v3.  Streams of several
+// documents separated by --- are compared document by document.
+func (a *Assertions) EqualsYAML(y interface{}) {
+	a.t.Helper()
+
+	if m, failed := a.evaluate(equalsDocument(yamlFormat{}, y)); failed {
+		formattedFailure(a, m.format, m.args...)
+	}
+}
+
+// yamlFormat is the Format of YAML, decoded and marshalled with
+// gopkg.in/yaml.v3.
+type yamlFormat struct{}
+
+func (yamlFormat) Name() string {
+	return "YAML"
+}
+
+// Parse decodes every document in the YAML stream text.
+func (yamlFormat) Parse(text string) ([]interface{}, error) {
+	dec := yaml.NewDecoder(strings.NewReader(text))
+
+	var docs []interface{}
+	for {
+		var doc interface{}
+		if err := dec.Decode(&doc); err == io.EOF {
+			return docs, nil
+		} else if err != nil {
+			return nil, err
+		}
+
+		docs = append(docs, doc)
+	}
+}
+
+func (yamlFormat) Marshal(v interface{}) ([]byte, error) {
+	return yaml.Marshal(v)
+}
@@ -0,0 +1,85 @@
+package test
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestEqualsYAML(t *testing.T) {
+	type metadata struct {
+		Name   string            `yaml:"name"`
+		Labels map[string]string `yaml:"labels"`
+	}
+
+	testCases := []struct {
+		x       interface{}
+		y       interface{}
+		pass    bool
+		message string
+	}{
+		{x: "name: web\nlabels:\n  app: web\n  tier: front\n", y: "# comment\nlabels: {tier: front, app: 'web'}\nname: \"web\"", pass: true},
+		{x: []byte("base: &base {a: 1}\nderived: *base"), y: "base: {a: 1}\nderived: {a: 1}", pass: true},
+		{x: strings.NewReader("name: web\nlabels:\n  app: web\n"), y: metadata{Name: "web", Labels: map[string]string{"app": "web"}}, pass: true},
+		{x: "replicas: 2\nratio: 1", y: "replicas: 2.0\nratio: 1.0", pass: true},
+		{x: "at: 2024-01-01T10:00:00+02:00", y: "at: 2024-01-01T08:00:00Z", pass: true},
+		{
+			x:       "at: 2024-01-01T10:00:00+02:00",
+			y:       "at: 2024-01-01T10:00:00Z",
+			pass:    false,
+			message: "Expected YAML documents to be equal, but they differ\n\n[\"at\"]: \"2024-01-01T08:00:00Z\" != \"2024-01-01T10:00:00Z\"",
+		},
+		{
+			x:       "name: web\nports: [80, 443]\nreplicas: 2",
+			y:       "name: api\nports: [80]\nreplicas: 3.0",
+			pass:    false,
+			message: "Expected YAML documents to be equal, but they differ\n\n[\"name\"]: \"web\" != \"api\"\n[\"ports\"][1]: 443 != <missing>\n[\"replicas\"]: 2 != 3",
+		},
+		{x: "a: [", y: "a: 1", pass: false, message: "Expected subject to be valid YAML, but it was not: yaml: line 1: did not find expected node content"},
+		{x: "a: 1", y: "a: [", pass: false, message: "Expected comparator to be valid YAML, but it was not: yaml: line 1: did not find expected node content\ny: string"},
+		{x: 5, y: "a: 1", pass: false, message: "Expected a string, []byte or io.Reader, but was not\nx: int"},
+	}
+
+	for _, testCase := range testCases {
+		recorder := NewRecorder()
+		That(recorder, testCase.x).EqualsYAML(testCase.y)
+
+		if !testCase.pass {
+			assertFailed(t, recorder)
+			assertHelperCount(t, recorder, 3)
+			assertFailureMessage(t, recorder, testCase.message)
+		} else {
+			assertPassed(t, recorder)
+			assertHelperCount(t, recorder, 2)
+		}
+	}
+}
+
+func TestEqualsYAMLMultipleDocuments(t *testing.T) {
+	// Arrange.
+	manifests := "kind: Service\nmetadata: {name: web}\n---\nkind: Deployment\nmetadata: {name: web}\nspec: {replicas: 3}\n"
+
+	// Act.
+	pass := NewRecorder()
+	That(pass, manifests).EqualsYAML("---\nkind: Service\nmetadata:\n  name: web\n---\nkind: Deployment\nspec:\n  replicas: 3\nmetadata:\n  name: web\n")
+
+	fail := NewRecorder()
+	That(fail, manifests).EqualsYAML("kind: Service\nmetadata: {name: web}\n---\nkind: Deployment\nmetadata: {name: web}\nspec: {replicas: 2}\n---\nkind: ConfigMap\n")
+
+	// Assert.
+	assertPassed(t, pass)
+
+	assertFailed(t, fail)
+	assertFailureMessage(t, fail, "Expected YAML documents to be equal, but they differ\n\n[1][\"spec\"][\"replicas\"]: 3 != 2\n[2]: <missing> != map[string]interface{}{\"kind\": \"ConfigMap\"}")
+}
+
+func TestNotEqualsYAML(t *testing.T) {
+	// Arrange.
+	recorder := NewRecorder()
+
+	// Act.
+	That(recorder, "a: 1").Not().EqualsYAML("{a: 1.0}")
+
+	// Assert.
+	assertFailed(t, recorder)
+	assertFailureMessage(t, recorder, "Expected YAML documents to not be equal, but they were")
+}